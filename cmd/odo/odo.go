@@ -88,8 +88,13 @@ func main() {
 
 	// Call commands
 	// checking the value of updatenotification in config
-	// before proceeding with fetching the latest version
-	if cfg.GetUpdateNotification() {
+	// before proceeding with fetching the latest version.
+	// The environment variable takes precedence over the preference
+	updateNotification := cfg.GetUpdateNotification()
+	if envConfig.OdoUpdateNotification != nil {
+		updateNotification = *envConfig.OdoUpdateNotification
+	}
+	if updateNotification {
 		updateInfo := make(chan string)
 		go version.GetLatestReleaseInfo(updateInfo)
 
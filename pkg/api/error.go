@@ -2,5 +2,14 @@ package api
 
 // GenericError for machine readable output error messages
 type GenericError struct {
+	// Kind is the category of the error (NotFound, Forbidden, ValidationFailed, etc), or Unknown
+	Kind string `json:"kind"`
+	// Reason further classifies the error within its kind, if known
+	Reason string `json:"reason,omitempty"`
+	// Message is the complete error message
 	Message string `json:"message"`
+	// Details is the message of the underlying error which determined the kind, if different from Message
+	Details string `json:"details,omitempty"`
+	// Suggestions are hints to solve the problem
+	Suggestions []string `json:"suggestions,omitempty"`
 }
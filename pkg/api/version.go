@@ -26,6 +26,8 @@ type OdoVersion struct {
 	GitCommit string       `json:"gitCommit"`
 	Cluster   *ClusterInfo `json:"cluster,omitempty"`
 	Podman    *PodmanInfo  `json:"podman,omitempty"`
+	// ImageBackends is the diagnosis of the backends used to build images, if requested
+	ImageBackends []ImageBackendInfo `json:"imageBackends,omitempty"`
 }
 
 type ClusterInfo struct {
@@ -45,3 +47,10 @@ type PodmanInfo struct {
 type PodmanClientInfo struct {
 	Version string `json:"version,omitempty"`
 }
+
+type ImageBackendInfo struct {
+	Name      string `json:"name"`
+	Available bool   `json:"available"`
+	Version   string `json:"version,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
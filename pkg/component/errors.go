@@ -2,6 +2,8 @@ package component
 
 import (
 	"fmt"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 )
 
 // NoComponentFoundError is returned when no component of the specified name was found.
@@ -22,3 +24,7 @@ func (e NoComponentFoundError) Error() string {
 	}
 	return fmt.Sprintf("no component found with name %q", e.name)
 }
+
+func (e NoComponentFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
@@ -772,8 +772,8 @@ func TestHandler(t *testing.T) {
 			},
 			imageBackend: func(ctrl *gomock.Controller) image.Backend {
 				client := image.NewMockBackend(ctrl)
-				client.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any())
-				client.EXPECT().Push("golang")
+				client.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+				client.EXPECT().Push("golang", gomock.Any(), gomock.Any())
 				return client
 
 			},
@@ -893,8 +893,8 @@ func TestHandler(t *testing.T) {
 			},
 			imageBackend: func(ctrl *gomock.Controller) image.Backend {
 				client := image.NewMockBackend(ctrl)
-				client.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any())
-				client.EXPECT().Push("golang")
+				client.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+				client.EXPECT().Push("golang", gomock.Any(), gomock.Any())
 				return client
 
 			},
@@ -1016,7 +1016,7 @@ func TestHandler(t *testing.T) {
 			},
 			imageBackend: func(ctrl *gomock.Controller) image.Backend {
 				client := image.NewMockBackend(ctrl)
-				client.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any())
+				client.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
 				return client
 
 			},
@@ -1139,7 +1139,7 @@ func TestHandler(t *testing.T) {
 			},
 			imageBackend: func(ctrl *gomock.Controller) image.Backend {
 				client := image.NewMockBackend(ctrl)
-				client.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any())
+				client.EXPECT().Build(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
 				return client
 
 			},
@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/sethvargo/go-envconfig"
@@ -14,6 +15,7 @@ type Configuration struct {
 	OdoDisableTelemetry           *bool         `env:"ODO_DISABLE_TELEMETRY,noinit"`
 	OdoLogLevel                   *int          `env:"ODO_LOG_LEVEL,noinit"`
 	OdoTrackingConsent            *string       `env:"ODO_TRACKING_CONSENT,noinit"`
+	OdoUpdateNotification         *bool         `env:"ODO_UPDATE_NOTIFICATION,noinit"`
 	PodmanCmd                     string        `env:"PODMAN_CMD,default=podman"`
 	PodmanCmdInitTimeout          time.Duration `env:"PODMAN_CMD_INIT_TIMEOUT,default=1s"`
 	TelemetryCaller               string        `env:"TELEMETRY_CALLER,default="`
@@ -21,7 +23,14 @@ type Configuration struct {
 	PushImages                    bool          `env:"ODO_PUSH_IMAGES,default=true"`
 	OdoContainerBackendGlobalArgs []string      `env:"ODO_CONTAINER_BACKEND_GLOBAL_ARGS,noinit,delimiter=;"`
 	OdoImageBuildArgs             []string      `env:"ODO_IMAGE_BUILD_ARGS,noinit,delimiter=;"`
+	OdoImageBuildConcurrency      *int          `env:"ODO_IMAGE_BUILD_CONCURRENCY,noinit"`
 	OdoContainerRunArgs           []string      `env:"ODO_CONTAINER_RUN_ARGS,noinit,delimiter=;"`
+	OdoSyncExecutablePatterns     []string      `env:"ODO_SYNC_EXECUTABLE_PATTERNS,default=mvnw;gradlew,delimiter=;"`
+	OdoWatchMaxBatchSize          int           `env:"ODO_WATCH_MAX_BATCH_SIZE,default=1000"`
+	OdoResourceDeletionTimeout    time.Duration `env:"ODO_RESOURCE_DELETION_TIMEOUT,default=3m"`
+	OdoServiceAccountTimeout      time.Duration `env:"ODO_SERVICE_ACCOUNT_TIMEOUT,default=1m"`
+	OdoSecretTimeout              time.Duration `env:"ODO_SECRET_TIMEOUT,default=3m"`
+	OdoRetryTimeout               time.Duration `env:"ODO_RETRY_TIMEOUT,default=30s"`
 }
 
 // GetConfiguration initializes a Configuration for odo by using the system environment.
@@ -43,5 +52,31 @@ func GetConfigurationWith(lookuper envconfig.Lookuper) (*Configuration, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = s.validate()
+	if err != nil {
+		return nil, err
+	}
 	return &s, nil
 }
+
+// validate checks that the timeouts are positive
+func (o Configuration) validate() error {
+	for _, timeout := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"PODMAN_CMD_INIT_TIMEOUT", o.PodmanCmdInitTimeout},
+		{"ODO_RESOURCE_DELETION_TIMEOUT", o.OdoResourceDeletionTimeout},
+		{"ODO_SERVICE_ACCOUNT_TIMEOUT", o.OdoServiceAccountTimeout},
+		{"ODO_SECRET_TIMEOUT", o.OdoSecretTimeout},
+		{"ODO_RETRY_TIMEOUT", o.OdoRetryTimeout},
+	} {
+		if timeout.value <= 0 {
+			return fmt.Errorf("invalid value for %s: %s, the timeout must be positive", timeout.name, timeout.value)
+		}
+	}
+	if o.OdoWatchMaxBatchSize <= 0 {
+		return fmt.Errorf("invalid value for ODO_WATCH_MAX_BATCH_SIZE: %d, the value must be positive", o.OdoWatchMaxBatchSize)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sethvargo/go-envconfig"
 )
@@ -24,6 +25,56 @@ func TestDefaultValues(t *testing.T) {
 	checkNilBool(t, "OdoDisableTelemetry", cfg.OdoDisableTelemetry)
 	checkNilString(t, "OdoTrackingConsent", cfg.OdoTrackingConsent)
 
+	checkDefaultDurationValue(t, "OdoResourceDeletionTimeout", cfg.OdoResourceDeletionTimeout, 3*time.Minute)
+	checkDefaultDurationValue(t, "OdoServiceAccountTimeout", cfg.OdoServiceAccountTimeout, time.Minute)
+	checkDefaultDurationValue(t, "OdoSecretTimeout", cfg.OdoSecretTimeout, 3*time.Minute)
+	checkDefaultDurationValue(t, "OdoRetryTimeout", cfg.OdoRetryTimeout, 30*time.Second)
+}
+
+func TestTimeoutValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr bool
+	}{
+		{
+			name: "positive timeouts",
+			env: map[string]string{
+				"ODO_RESOURCE_DELETION_TIMEOUT": "10s",
+				"ODO_SERVICE_ACCOUNT_TIMEOUT":   "1ms",
+				"ODO_SECRET_TIMEOUT":            "1h",
+				"ODO_RETRY_TIMEOUT":             "5s",
+			},
+		},
+		{
+			name:    "zero timeout",
+			env:     map[string]string{"ODO_SECRET_TIMEOUT": "0s"},
+			wantErr: true,
+		},
+		{
+			name:    "negative timeout",
+			env:     map[string]string{"ODO_RESOURCE_DELETION_TIMEOUT": "-1m"},
+			wantErr: true,
+		},
+		{
+			name:    "zero podman init timeout",
+			env:     map[string]string{"PODMAN_CMD_INIT_TIMEOUT": "0"},
+			wantErr: true,
+		},
+		{
+			name:    "zero max batch size",
+			env:     map[string]string{"ODO_WATCH_MAX_BATCH_SIZE": "0"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GetConfigurationWith(envconfig.MapLookuper(tt.env))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetConfigurationWith() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
 }
 
 func checkDefaultStringValue(t *testing.T, fieldName string, field string, def string) {
@@ -40,6 +91,12 @@ func checkDefaultBoolValue(t *testing.T, fieldName string, field bool, def bool)
 
 }
 
+func checkDefaultDurationValue(t *testing.T, fieldName string, field time.Duration, def time.Duration) {
+	if field != def {
+		t.Errorf("default value for %q should be %s but is %s", fieldName, def, field)
+	}
+}
+
 func checkNilString(t *testing.T, fieldName string, field *string) {
 	if field != nil {
 		t.Errorf("value for non specified env var %q should be nil but is %q", fieldName, *field)
@@ -24,11 +24,12 @@ func Run(
 ) error {
 	var (
 		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
 		devfileObj    = odocontext.GetEffectiveDevfileObj(ctx)
 		devfilePath   = odocontext.GetDevfilePath(ctx)
 	)
 
-	pod, err := platformClient.GetPodUsingComponentName(componentName)
+	pod, err := platformClient.GetPodUsingComponentName(componentName, appName)
 	if err != nil {
 		return fmt.Errorf("unable to get pod for component %s: %w. Please check the command 'odo dev' is running", componentName, err)
 	}
@@ -5,6 +5,15 @@ import (
 	"github.com/redhat-developer/odo/pkg/dev"
 )
 
+const (
+	// DeployStep is the name of the step reported when the component is deployed on the platform
+	DeployStep = "Deploying the component"
+	// BuildStep is the name of the step reported when the build command is executed
+	BuildStep = "Building the application"
+	// RunStep is the name of the step reported when the run or debug command is executed
+	RunStep = "Running the application"
+)
+
 // PushParameters is a struct containing the parameters to be used when pushing to a devfile component
 type PushParameters struct {
 	StartOptions dev.StartOptions
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 )
 
 type StartOptions struct {
@@ -39,6 +40,8 @@ type StartOptions struct {
 	Variables map[string]string
 	// PushWatcher is a channel that will emit an event when Pushing files to the component is requested
 	PushWatcher <-chan struct{}
+	// EventReporter reports the progress of the Dev session, for machine-readable output. No event is reported if nil
+	EventReporter machineoutput.EventReporter
 
 	Out    io.Writer
 	ErrOut io.Writer
@@ -11,10 +11,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/redhat-developer/odo/pkg/component"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/port"
 	"github.com/redhat-developer/odo/pkg/sync"
@@ -26,12 +28,13 @@ import (
 func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParameters, componentStatus *watch.ComponentStatus) error {
 	var (
 		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
 		devfilePath   = odocontext.GetDevfilePath(ctx)
 		path          = filepath.Dir(devfilePath)
 	)
 
 	// Now the Deployment has a Ready replica, we can get the Pod to work inside it
-	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
+	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName, appName)
 	if err != nil {
 		return fmt.Errorf("unable to get pod for component %s: %w", componentName, err)
 	}
@@ -151,13 +154,15 @@ func (o *DevClient) innerloop(ctx context.Context, parameters common.PushParamet
 				)
 				return libdevfile.Build(ctx, parameters.Devfile, parameters.StartOptions.BuildCommand, execHandler)
 			}
-			if err = doExecuteBuildCommand(); err != nil {
+			if err = machineoutput.ReportStep(parameters.StartOptions.EventReporter, common.BuildStep, doExecuteBuildCommand); err != nil {
 				componentStatus.SetState(watch.StateReady)
 				return err
 			}
 
 			if hasRunOrDebugCmd {
-				err = libdevfile.ExecuteCommandByNameAndKind(ctx, parameters.Devfile, cmdName, cmdKind, runHandler, false)
+				err = machineoutput.ReportStep(parameters.StartOptions.EventReporter, common.RunStep, func() error {
+					return libdevfile.ExecuteCommandByNameAndKind(ctx, parameters.Devfile, cmdName, cmdKind, runHandler, false)
+				})
 				if err != nil {
 					return err
 				}
@@ -252,11 +257,15 @@ func (o *DevClient) syncFiles(ctx context.Context, parameters common.PushParamet
 		WatchFiles:               parameters.WatchFiles,
 		WatchDeletedFiles:        parameters.WatchDeletedFiles,
 		IgnoredFiles:             parameters.StartOptions.IgnorePaths,
+		ExecutablePatterns:       envcontext.GetEnvConfig(ctx).OdoSyncExecutablePatterns,
 		DevfileScanIndexForWatch: parameters.DevfileScanIndexForWatch,
 
 		CompInfo:  compInfo,
 		ForcePush: !o.deploymentExists || podChanged,
 		Files:     syncFilesMap,
+		Progress: func(written, total int64) {
+			s.ProgressStatus(sync.FormatProgress(written, total))
+		},
 	}
 
 	execRequired, err := o.syncClient.SyncFiles(ctx, syncParams)
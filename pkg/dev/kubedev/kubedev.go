@@ -8,6 +8,7 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/binding"
 	_delete "github.com/redhat-developer/odo/pkg/component/delete"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/configAutomount"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
@@ -90,6 +91,7 @@ func (o *DevClient) Start(
 		StartOptions:        options,
 		DevfileWatchHandler: o.regenerateAdapterAndPush,
 		WatchCluster:        true,
+		MaxBatchSize:        envcontext.GetEnvConfig(ctx).OdoWatchMaxBatchSize,
 	}
 
 	return o.watchClient.WatchAndPush(ctx, watchParameters, componentStatus)
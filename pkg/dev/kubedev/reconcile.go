@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/redhat-developer/odo/pkg/watch"
 )
 
@@ -14,7 +15,10 @@ func (o *DevClient) reconcile(ctx context.Context, parameters common.PushParamet
 
 	// podOK indicates if the pod is ready to use for the inner loop
 	var podOK bool
-	podOK, err = o.createComponents(ctx, parameters, componentStatus)
+	err = machineoutput.ReportStep(parameters.StartOptions.EventReporter, common.DeployStep, func() (err error) {
+		podOK, err = o.createComponents(ctx, parameters, componentStatus)
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"k8s.io/klog"
 
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile"
@@ -84,6 +85,7 @@ func (o *DevClient) Start(
 		StartOptions:        options,
 		DevfileWatchHandler: o.watchHandler,
 		WatchCluster:        false,
+		MaxBatchSize:        envcontext.GetEnvConfig(ctx).OdoWatchMaxBatchSize,
 	}
 
 	return o.watchClient.WatchAndPush(ctx, watchParameters, componentStatus)
@@ -139,11 +141,15 @@ func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod
 		WatchFiles:               nil,
 		WatchDeletedFiles:        nil,
 		IgnoredFiles:             options.IgnorePaths,
+		ExecutablePatterns:       envcontext.GetEnvConfig(ctx).OdoSyncExecutablePatterns,
 		DevfileScanIndexForWatch: true,
 
 		CompInfo:  compInfo,
 		ForcePush: true,
 		Files:     syncFilesMap,
+		Progress: func(written, total int64) {
+			s.ProgressStatus(sync.FormatProgress(written, total))
+		},
 	}
 	execRequired, err := o.syncClient.SyncFiles(ctx, syncParams)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/port"
 	"github.com/redhat-developer/odo/pkg/watch"
@@ -49,7 +50,14 @@ func (o *DevClient) reconcile(
 		return err
 	}
 
-	pod, fwPorts, err := o.deployPod(ctx, options, devfileObj)
+	var (
+		pod     *corev1.Pod
+		fwPorts []api.ForwardedPort
+	)
+	err = machineoutput.ReportStep(options.EventReporter, common.DeployStep, func() (err error) {
+		pod, fwPorts, err = o.deployPod(ctx, options, devfileObj)
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -106,7 +114,7 @@ func (o *DevClient) reconcile(
 				return libdevfile.Build(ctx, devfileObj, options.BuildCommand, execHandler)
 			}
 
-			err = doExecuteBuildCommand()
+			err = machineoutput.ReportStep(options.EventReporter, common.BuildStep, doExecuteBuildCommand)
 			if err != nil {
 				return err
 			}
@@ -139,7 +147,9 @@ func (o *DevClient) reconcile(
 						ContainersRunning: component.GetContainersNames(pod),
 					},
 				)
-				err = libdevfile.ExecuteCommandByNameAndKind(ctx, devfileObj, cmdName, cmdKind, cmdHandler, false)
+				err = machineoutput.ReportStep(options.EventReporter, common.RunStep, func() error {
+					return libdevfile.ExecuteCommandByNameAndKind(ctx, devfileObj, cmdName, cmdKind, cmdHandler, false)
+				})
 				if err != nil {
 					return err
 				}
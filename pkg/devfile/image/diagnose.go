@@ -0,0 +1,138 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"k8s.io/klog"
+
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const (
+	// diagnoseTimeout is the maximal duration of the diagnosis of a single backend,
+	// so that a hung daemon does not block the diagnosis forever
+	diagnoseTimeout = 30 * time.Second
+
+	// diagnoseDockerfile is built to check that the backend is able to build images.
+	// It does not need any base image, so it can be built without network access
+	diagnoseDockerfile = "FROM scratch\nLABEL io.odo.diagnose=true\n"
+
+	// diagnoseImage is the tag of the image built by the diagnosis, removed after the diagnosis
+	diagnoseImage = "localhost/odo-diagnose:latest"
+)
+
+// BackendDiagnosis is the result of the diagnosis of a container backend
+type BackendDiagnosis struct {
+	// Name is the command of the backend
+	Name string
+	// Available is true if the backend has been found and is able to build images
+	Available bool
+	// Version is the version of the backend, if it could be determined
+	Version string
+	// Error is the reason why the backend is not available
+	Error string
+}
+
+// Diagnose checks each container backend odo is able to use (podman and docker, in this order),
+// by getting its version and building a trivial image with it.
+// The diagnosis of each backend is limited to diagnoseTimeout.
+func Diagnose(ctx context.Context, fs filesystem.Filesystem) []BackendDiagnosis {
+	var (
+		envConfig       = envcontext.GetEnvConfig(ctx)
+		globalExtraArgs = envConfig.OdoContainerBackendGlobalArgs
+		buildExtraArgs  = envConfig.OdoImageBuildArgs
+	)
+	backends := []Backend{
+		NewDockerCompatibleBackend(envConfig.PodmanCmd, globalExtraArgs, buildExtraArgs),
+		NewDockerCompatibleBackend(envConfig.DockerCmd, globalExtraArgs, buildExtraArgs),
+	}
+	result := make([]BackendDiagnosis, 0, len(backends))
+	for _, backend := range backends {
+		result = append(result, diagnoseBackend(ctx, backend, fs, diagnoseTimeout))
+	}
+	return result
+}
+
+// diagnoseBackend checks that the backend is installed, gets its version and builds diagnoseDockerfile
+func diagnoseBackend(ctx context.Context, backend Backend, fs filesystem.Filesystem, timeout time.Duration) BackendDiagnosis {
+	name := backend.String()
+	diagnosis := BackendDiagnosis{
+		Name: name,
+	}
+
+	if _, err := lookPathCmd(name); err != nil {
+		diagnosis.Error = fmt.Sprintf("%s not found: %v", name, err)
+		return diagnosis
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	version, err := backend.Version(ctx)
+	if ctx.Err() != nil {
+		err = fmt.Errorf("%s did not respond in time: %w", name, ctx.Err())
+	}
+	if err != nil {
+		diagnosis.Error = fmt.Sprintf("unable to get %s version: %v", name, err)
+		return diagnosis
+	}
+	diagnosis.Version = version
+
+	// the build is stopped after the timeout
+	err = diagnoseBuild(ctx, backend, fs)
+	if ctx.Err() != nil {
+		err = fmt.Errorf("%s did not respond in time: %w", name, ctx.Err())
+	}
+	if err != nil {
+		diagnosis.Error = fmt.Sprintf("unable to build an image with %s: %v", name, err)
+		return diagnosis
+	}
+	if err = backend.RemoveImage(ctx, diagnoseImage); err != nil {
+		klog.V(4).Infof("could not remove the image %q: %v", diagnoseImage, err)
+	}
+
+	diagnosis.Available = true
+	return diagnosis
+}
+
+// diagnoseBuild builds diagnoseDockerfile into diagnoseImage, from an empty build context
+func diagnoseBuild(ctx context.Context, backend Backend, fs filesystem.Filesystem) error {
+	dir, err := fs.TempDir("", "odo-diagnose-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := fs.RemoveAll(dir); e != nil {
+			klog.V(4).Infof("could not remove temporary directory %q: %v", dir, e)
+		}
+	}()
+
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	err = fs.WriteFile(dockerfile, []byte(diagnoseDockerfile), 0600)
+	if err != nil {
+		return err
+	}
+
+	image := &devfile.ImageComponent{
+		Image: devfile.Image{
+			ImageName: diagnoseImage,
+			ImageUnion: devfile.ImageUnion{
+				Dockerfile: &devfile.DockerfileImage{
+					DockerfileSrc: devfile.DockerfileSrc{
+						Uri: dockerfile,
+					},
+					Dockerfile: devfile.Dockerfile{
+						BuildContext: dir,
+					},
+				},
+			},
+		},
+	}
+	return backend.Build(ctx, fs, image, dir, io.Discard, io.Discard, true)
+}
@@ -0,0 +1,133 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/golang/mock/gomock"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestDiagnose(t *testing.T) {
+	tests := []struct {
+		name        string
+		lookPathCmd func(string) (string, error)
+		// backend sets the expectations of the backend
+		backend func(fs filesystem.Filesystem, backend *MockBackend)
+		// want is the expected diagnosis, except for the Error field
+		want BackendDiagnosis
+		// wantErr is a substring of the expected error
+		wantErr string
+	}{
+		{
+			name: "backend is not installed",
+			lookPathCmd: func(string) (string, error) {
+				return "", errors.New("executable file not found in $PATH")
+			},
+			backend: func(filesystem.Filesystem, *MockBackend) {},
+			want:    BackendDiagnosis{Name: "docker"},
+			wantErr: "docker not found",
+		},
+		{
+			name: "backend is available",
+			lookPathCmd: func(name string) (string, error) {
+				return name, nil
+			},
+			backend: func(fs filesystem.Filesystem, backend *MockBackend) {
+				backend.EXPECT().Version(gomock.Any()).Return("4.5.0", nil)
+				backend.EXPECT().Build(gomock.Any(), fs, gomock.Any(), gomock.Any(), io.Discard, io.Discard, true).
+					DoAndReturn(func(_ context.Context, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, _, _ io.Writer, _ bool) error {
+						if image.ImageName != diagnoseImage {
+							t.Errorf("expected image %q to be built, got %q", diagnoseImage, image.ImageName)
+						}
+						content, err := fs.ReadFile(filepath.Join(devfilePath, "Dockerfile"))
+						if err != nil {
+							t.Errorf("unable to read the Dockerfile: %v", err)
+						}
+						if string(content) != diagnoseDockerfile {
+							t.Errorf("unexpected content of the Dockerfile: %q", string(content))
+						}
+						return nil
+					})
+				backend.EXPECT().RemoveImage(gomock.Any(), diagnoseImage).Return(nil)
+			},
+			want: BackendDiagnosis{Name: "docker", Available: true, Version: "4.5.0"},
+		},
+		{
+			name: "daemon is hung when getting the version",
+			lookPathCmd: func(name string) (string, error) {
+				return name, nil
+			},
+			backend: func(fs filesystem.Filesystem, backend *MockBackend) {
+				backend.EXPECT().Version(gomock.Any()).DoAndReturn(func(ctx context.Context) (string, error) {
+					<-ctx.Done()
+					return "", errors.New("signal: killed")
+				})
+			},
+			want:    BackendDiagnosis{Name: "docker"},
+			wantErr: "did not respond in time",
+		},
+		{
+			name: "daemon is hung when building",
+			lookPathCmd: func(name string) (string, error) {
+				return name, nil
+			},
+			backend: func(fs filesystem.Filesystem, backend *MockBackend) {
+				backend.EXPECT().Version(gomock.Any()).Return("24.0.2", nil)
+				backend.EXPECT().Build(gomock.Any(), fs, gomock.Any(), gomock.Any(), io.Discard, io.Discard, true).
+					DoAndReturn(func(ctx context.Context, _ filesystem.Filesystem, _ *devfile.ImageComponent, _ string, _, _ io.Writer, _ bool) error {
+						// the build is stopped after the timeout
+						<-ctx.Done()
+						return errors.New("signal: killed")
+					})
+			},
+			want:    BackendDiagnosis{Name: "docker", Version: "24.0.2"},
+			wantErr: "did not respond in time",
+		},
+		{
+			name: "build fails",
+			lookPathCmd: func(name string) (string, error) {
+				return name, nil
+			},
+			backend: func(fs filesystem.Filesystem, backend *MockBackend) {
+				backend.EXPECT().Version(gomock.Any()).Return("24.0.2", nil)
+				backend.EXPECT().Build(gomock.Any(), fs, gomock.Any(), gomock.Any(), io.Discard, io.Discard, true).
+					Return(errors.New("error running docker command: exit status 1\nCannot connect to the daemon"))
+			},
+			want:    BackendDiagnosis{Name: "docker", Version: "24.0.2"},
+			wantErr: "Cannot connect to the daemon",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookPathCmd = tt.lookPathCmd
+			defer func() {
+				lookPathCmd = exec.LookPath
+			}()
+
+			ctrl := gomock.NewController(t)
+			fs := filesystem.NewFakeFs()
+			backend := NewMockBackend(ctrl)
+			backend.EXPECT().String().Return("docker").AnyTimes()
+			tt.backend(fs, backend)
+
+			got := diagnoseBackend(context.Background(), backend, fs, 100*time.Millisecond)
+
+			if !strings.Contains(got.Error, tt.wantErr) || (tt.wantErr == "") != (got.Error == "") {
+				t.Errorf("expected error to contain %q, got %q", tt.wantErr, got.Error)
+			}
+			got.Error = ""
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -1,11 +1,16 @@
 package image
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/fatih/color"
@@ -35,7 +40,7 @@ func NewDockerCompatibleBackend(name string, globalExtraArgs, imageBuildExtraArg
 }
 
 // Build an image, as defined in devfile, using a Docker compatible CLI
-func (o *DockerCompatibleBackend) Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string) error {
+func (o *DockerCompatibleBackend) Build(ctx context.Context, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, out, errOut io.Writer, quiet bool) error {
 
 	dockerfile, isTemp, err := resolveAndDownloadDockerfile(fs, image.Dockerfile.Uri)
 	if isTemp {
@@ -49,44 +54,119 @@ func (o *DockerCompatibleBackend) Build(fs filesystem.Filesystem, image *devfile
 		return err
 	}
 
-	// We use a "No Spin" since we are outputting to stdout / stderr
-	buildSpinner := log.SpinnerNoSpin("Building image locally")
-	defer buildSpinner.End(false)
+	// the variables are only defined for the command, as several builds can be running concurrently
+	projectEnv := map[string]string{
+		"PROJECTS_ROOT":  devfilePath,
+		"PROJECT_SOURCE": devfilePath,
+	}
+	shellCmd := getShellCommand(o.name, o.globalExtraArgs, o.imageBuildExtraArgs, image, devfilePath, dockerfile)
+	klog.V(4).Infof("Running command: %v", shellCmd)
+	for i, cmd := range shellCmd {
+		shellCmd[i] = os.Expand(cmd, func(name string) string {
+			if value, ok := projectEnv[name]; ok {
+				return value
+			}
+			return os.Getenv(name)
+		})
+	}
+	cmd := exec.CommandContext(ctx, shellCmd[0], shellCmd[1:]...)
+	cmd.Env = append(os.Environ(),
+		"PROJECTS_ROOT="+devfilePath,
+		"PROJECT_SOURCE="+devfilePath,
+	)
 
-	err = os.Setenv("PROJECTS_ROOT", devfilePath)
+	streamer := newOutputStreamer(quiet)
+	err = runStreamed(cmd, streamer, out, errOut)
 	if err != nil {
-		return err
+		if quiet {
+			return fmt.Errorf("error running %s command: %w\n%s", o.name, err, strings.Join(streamer.tail, "\n"))
+		}
+		return fmt.Errorf("error running %s command: %w", o.name, err)
 	}
+	return nil
+}
 
-	err = os.Setenv("PROJECT_SOURCE", devfilePath)
-	if err != nil {
-		return err
+// buildOutputTailLines is the number of lines of the build output kept for error context in quiet mode
+const buildOutputTailLines = 50
+
+// italic is used to write the outputs of the commands.
+// The color is set for each line instead of globally, as several commands can be running concurrently
+var italic = color.New(color.Italic)
+
+// outputStreamer writes the outputs of a command to writers line by line, in italic,
+// and keeps the last lines of the outputs
+type outputStreamer struct {
+	quiet bool
+	// mu protects tail, which is written when streaming the standard and error outputs
+	mu   sync.Mutex
+	tail []string
+}
+
+func newOutputStreamer(quiet bool) *outputStreamer {
+	return &outputStreamer{
+		quiet: quiet,
 	}
+}
 
-	shellCmd := getShellCommand(o.name, o.globalExtraArgs, o.imageBuildExtraArgs, image, devfilePath, dockerfile)
-	klog.V(4).Infof("Running command: %v", shellCmd)
-	for i, cmd := range shellCmd {
-		shellCmd[i] = os.ExpandEnv(cmd)
+// stream reads r until EOF and writes each line to out as soon as it is read,
+// except in quiet mode
+func (o *outputStreamer) stream(r io.Reader, out io.Writer) error {
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			o.keep(strings.TrimRight(line, "\r\n"))
+			if !o.quiet {
+				if _, werr := italic.Fprint(out, line); werr != nil {
+					return werr
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
 	}
-	cmd := exec.Command(shellCmd[0], shellCmd[1:]...)
-	cmdEnv := []string{
-		"PROJECTS_ROOT=" + devfilePath,
-		"PROJECT_SOURCE=" + devfilePath,
+}
+
+// keep keeps line in the tail of the output, discarding the oldest line if needed
+func (o *outputStreamer) keep(line string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.tail) == buildOutputTailLines {
+		o.tail = append(o.tail[:0], o.tail[1:]...)
 	}
-	cmd.Env = append(os.Environ(), cmdEnv...)
-	cmd.Stdout = log.GetStdout()
-	cmd.Stderr = log.GetStderr()
+	o.tail = append(o.tail, line)
+}
 
-	// Set all output as italic when doing a push, then return to normal at the end
-	color.Set(color.Italic)
-	defer color.Unset()
-	err = cmd.Run()
-	if err != nil {
-		return fmt.Errorf("error running %s command: %w", o.name, err)
+// runStreamed runs cmd and streams its stdout to out and its stderr to errOut with streamer
+func runStreamed(cmd *exec.Cmd, streamer *outputStreamer, out, errOut io.Writer) error {
+	stdoutReader, stdoutWriter := io.Pipe()
+	stderrReader, stderrWriter := io.Pipe()
+	cmd.Stdout = stdoutWriter
+	cmd.Stderr = stderrWriter
+
+	streamErr := make(chan error, 2)
+	streamTo := func(r io.Reader, w io.Writer) {
+		err := streamer.stream(r, w)
+		// make sure the command does not block writing to the pipe if streaming failed
+		_, _ = io.Copy(io.Discard, r)
+		streamErr <- err
 	}
+	go streamTo(stdoutReader, out)
+	go streamTo(stderrReader, errOut)
 
-	buildSpinner.End(true)
-	return nil
+	err := cmd.Run()
+	_ = stdoutWriter.Close()
+	_ = stderrWriter.Close()
+	for i := 0; i < 2; i++ {
+		if e := <-streamErr; e != nil {
+			klog.V(4).Infof("error streaming command output: %v", e)
+		}
+	}
+	return err
 }
 
 // resolveAndDownloadDockerfile resolves and downloads (if needed) the specified Dockerfile URI.
@@ -149,27 +229,28 @@ func getShellCommand(cmdName string, globalExtraArgs []string, buildExtraArgs []
 }
 
 // Push an image to its registry using a Docker compatible CLI
-func (o *DockerCompatibleBackend) Push(image string) error {
-
-	// We use a "No Spin" since we are outputting to stdout / stderr
-	pushSpinner := log.SpinnerNoSpin("Pushing image to container registry")
-	defer pushSpinner.End(false)
+func (o *DockerCompatibleBackend) Push(image string, out, errOut io.Writer) error {
 	klog.V(4).Infof("Running command: %s push %s", o.name, image)
 
 	cmd := exec.Command(o.name, "push", image)
-
-	cmd.Stdout = log.GetStdout()
-	cmd.Stderr = log.GetStderr()
-
-	// Set all output as italic when doing a push, then return to normal at the end
-	color.Set(color.Italic)
-	defer color.Unset()
-	err := cmd.Run()
+	err := runStreamed(cmd, newOutputStreamer(false), out, errOut)
 	if err != nil {
 		return fmt.Errorf("error running %s command: %w", o.name, err)
 	}
+	return nil
+}
+
+// RemoveImage removes an image from the local storage, using a Docker compatible CLI
+func (o *DockerCompatibleBackend) RemoveImage(ctx context.Context, image string) error {
+	args := make([]string, 0, len(o.globalExtraArgs)+2)
+	args = append(args, o.globalExtraArgs...)
+	args = append(args, "rmi", image)
+	klog.V(4).Infof("Running command: %s %v", o.name, args)
 
-	pushSpinner.End(true)
+	output, err := exec.CommandContext(ctx, o.name, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("error running %s command: %w: %s", o.name, err, strings.TrimSpace(string(output)))
+	}
 	return nil
 }
 
@@ -177,3 +258,24 @@ func (o *DockerCompatibleBackend) Push(image string) error {
 func (o *DockerCompatibleBackend) String() string {
 	return o.name
 }
+
+// Version returns the version of the Docker compatible CLI
+func (o *DockerCompatibleBackend) Version(ctx context.Context) (string, error) {
+	args := make([]string, 0, len(o.globalExtraArgs)+3)
+	args = append(args, o.globalExtraArgs...)
+	args = append(args, "version", "--format", "{{.Client.Version}}")
+	klog.V(4).Infof("Running command: %s %v", o.name, args)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, o.name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		if output := strings.TrimSpace(stderr.String()); output != "" {
+			return "", fmt.Errorf("error running %s command: %w: %s", o.name, err, output)
+		}
+		return "", fmt.Errorf("error running %s command: %w", o.name, err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
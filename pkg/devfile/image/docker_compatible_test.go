@@ -1,12 +1,19 @@
 package image
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/google/go-cmp/cmp"
@@ -253,3 +260,117 @@ func Test_resolveAndDownloadDockerfile(t *testing.T) {
 		})
 	}
 }
+
+// chanWriter sends each write to a channel
+type chanWriter chan string
+
+func (o chanWriter) Write(p []byte) (int, error) {
+	o <- string(p)
+	return len(p), nil
+}
+
+func Test_outputStreamer_incremental(t *testing.T) {
+	pr, pw := io.Pipe()
+	writes := make(chanWriter, 10)
+	streamer := newOutputStreamer(false)
+	done := make(chan error)
+	go func() {
+		done <- streamer.stream(pr, writes)
+	}()
+
+	for _, line := range []string{"STEP 1/2: FROM scratch\n", "STEP 2/2: LABEL a=b\n"} {
+		// the fake command waits for the line to be written before outputting the next one
+		if _, err := io.WriteString(pw, line); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		select {
+		case got := <-writes:
+			if got != line {
+				t.Errorf("expected %q to be written, got %q", line, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("line %q has not been written before the end of the command", line)
+		}
+	}
+	_ = pw.Close()
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func Test_outputStreamer_quiet(t *testing.T) {
+	var input strings.Builder
+	for i := 1; i <= 60; i++ {
+		fmt.Fprintf(&input, "line %d\n", i)
+	}
+	// last line without a newline
+	input.WriteString("error: build failed")
+
+	var out bytes.Buffer
+	streamer := newOutputStreamer(true)
+	err := streamer.stream(strings.NewReader(input.String()), &out)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("nothing should be written in quiet mode, got %q", out.String())
+	}
+	if len(streamer.tail) != buildOutputTailLines {
+		t.Fatalf("expected %d lines to be kept, got %d", buildOutputTailLines, len(streamer.tail))
+	}
+	if streamer.tail[0] != "line 12" {
+		t.Errorf("expected first kept line to be %q, got %q", "line 12", streamer.tail[0])
+	}
+	if last := streamer.tail[len(streamer.tail)-1]; last != "error: build failed" {
+		t.Errorf("expected last kept line to be %q, got %q", "error: build failed", last)
+	}
+}
+
+func Test_runStreamed_separateOutputs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires a POSIX shell")
+	}
+	var out, errOut bytes.Buffer
+	cmd := exec.Command("sh", "-c", "echo building; echo warning >&2; exit 1")
+	err := runStreamed(cmd, newOutputStreamer(false), &out, &errOut)
+	if err == nil {
+		t.Fatal("expected the error of the command")
+	}
+	if out.String() != "building\n" {
+		t.Errorf("expected standard output %q, got %q", "building\n", out.String())
+	}
+	if errOut.String() != "warning\n" {
+		t.Errorf("expected error output %q, got %q", "warning\n", errOut.String())
+	}
+}
+
+func TestDockerCompatibleBackend_Build_projectEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires echo")
+	}
+	t.Setenv("PROJECTS_ROOT", "")
+	image := &devfile.ImageComponent{
+		Image: devfile.Image{
+			ImageName: "registry.io/myimagename:tag",
+			ImageUnion: devfile.ImageUnion{
+				Dockerfile: &devfile.DockerfileImage{
+					DockerfileSrc: devfile.DockerfileSrc{Uri: "Dockerfile"},
+					Dockerfile:    devfile.Dockerfile{BuildContext: "${PROJECTS_ROOT}"},
+				},
+			},
+		},
+	}
+	var out bytes.Buffer
+	// "echo build -t <image> -f <dockerfile> <context>" outputs the arguments of the command
+	backend := NewDockerCompatibleBackend("echo", nil, nil)
+	err := backend.Build(context.Background(), filesystem.NewFakeFs(), image, "/project", &out, io.Discard, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasSuffix(strings.TrimSpace(out.String()), " /project") {
+		t.Errorf("expected the build context to be expanded to the devfile path, got %q", out.String())
+	}
+	if value := os.Getenv("PROJECTS_ROOT"); value != "" {
+		t.Errorf("the environment of odo should not be modified, got PROJECTS_ROOT=%q", value)
+	}
+}
@@ -2,13 +2,20 @@
 package image
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"sync"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog"
 
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
@@ -19,13 +26,20 @@ import (
 
 // Backend is in interface that must be implemented by container runtimes
 type Backend interface {
-	// Build the image as defined in the devfile.
+	// Build the image as defined in the devfile. The build is stopped when ctx is done.
 	// The filesystem specified will be used to download and store the Dockerfile if it is referenced as a remote URL.
-	Build(fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string) error
-	// Push the image to its registry as defined in the devfile
-	Push(image string) error
+	// The standard and error outputs of the build are streamed to out and errOut line by line, unless quiet is true,
+	// in which case only the last lines of the outputs are reported in case of error.
+	Build(ctx context.Context, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, out, errOut io.Writer, quiet bool) error
+	// Push the image to its registry as defined in the devfile.
+	// The standard and error outputs of the push are streamed to out and errOut line by line.
+	Push(image string, out, errOut io.Writer) error
+	// RemoveImage removes the image from the local storage of the backend
+	RemoveImage(ctx context.Context, image string) error
 	// Return the name of the backend
 	String() string
+	// Version returns the version of the client of the backend
+	Version(ctx context.Context) (string, error)
 }
 
 var lookPathCmd = exec.LookPath
@@ -55,13 +69,145 @@ func BuildPushImages(ctx context.Context, backend Backend, fs filesystem.Filesys
 		return libdevfile.NewComponentTypeNotFoundError(devfile.ImageComponentType)
 	}
 
+	concurrency := defaultBuildConcurrency()
+	if c := envcontext.GetEnvConfig(ctx).OdoImageBuildConcurrency; c != nil {
+		concurrency = *c
+	}
+
+	if concurrency <= 1 || len(components) == 1 {
+		for _, component := range components {
+			err = buildPushImage(ctx, backend, fs, component.Image, path, push, log.GetStdout(), log.GetStderr(), true)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	images := make([]*devfile.ImageComponent, 0, len(components))
 	for _, component := range components {
-		err = buildPushImage(backend, fs, component.Image, path, push)
-		if err != nil {
-			return err
+		images = append(images, component.Image)
+	}
+	return BuildAll(ctx, fs, images, path, backend, concurrency, push, log.GetStdout(), log.GetStderr())
+}
+
+// maxDefaultBuildConcurrency is the maximal number of images built concurrently by default,
+// as builds are generally limited by the network and the disk more than by the CPU
+const maxDefaultBuildConcurrency = 4
+
+// defaultBuildConcurrency returns the number of images built concurrently when ODO_IMAGE_BUILD_CONCURRENCY is not set:
+// the number of CPUs, capped at maxDefaultBuildConcurrency
+func defaultBuildConcurrency() int {
+	if n := runtime.NumCPU(); n < maxDefaultBuildConcurrency {
+		return n
+	}
+	return maxDefaultBuildConcurrency
+}
+
+// BuildAll builds the images using the provided backend, with at most concurrency builds running at the same time.
+// If push is true, each image is pushed to its registry after being built.
+// The standard and error outputs of each build and push are written to out and errOut, each line being prefixed with the name of the image.
+// No spinner is displayed, as the outputs of the builds are interleaved.
+// After the first failure, no new build is started, but the running ones are waited for.
+// The returned error aggregates the errors of all the failed builds.
+func BuildAll(ctx context.Context, fs filesystem.Filesystem, images []*devfile.ImageComponent, devfilePath string, backend Backend, concurrency int, push bool, out, errOut io.Writer) error {
+	for _, image := range images {
+		if image == nil {
+			return errors.New("image should not be nil")
 		}
 	}
-	return nil
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		// slots limits the number of running builds
+		slots = make(chan struct{}, concurrency)
+		wg    sync.WaitGroup
+		// outMu serializes the writes of the different builds to out and errOut
+		outMu sync.Mutex
+		// mu protects failed and errs
+		mu     sync.Mutex
+		failed bool
+		errs   []error
+	)
+	hasFailed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return failed
+	}
+
+	for _, image := range images {
+		slots <- struct{}{}
+		if hasFailed() {
+			<-slots
+			break
+		}
+		wg.Add(1)
+		go func(image *devfile.ImageComponent) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			prefix := fmt.Sprintf("[%s] ", image.ImageName)
+			w := &prefixWriter{mu: &outMu, out: out, prefix: prefix}
+			errW := &prefixWriter{mu: &outMu, out: errOut, prefix: prefix}
+			err := buildPushImage(ctx, backend, fs, image, devfilePath, push, w, errW, false)
+			w.flush()
+			errW.flush()
+			if err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				failed = true
+				if push {
+					errs = append(errs, fmt.Errorf("unable to build and push image %q: %w", image.ImageName, err))
+				} else {
+					errs = append(errs, fmt.Errorf("unable to build image %q: %w", image.ImageName, err))
+				}
+			}
+		}(image)
+	}
+	wg.Wait()
+	return utilerrors.NewAggregate(errs)
+}
+
+// prefixWriter writes each line to out prefixed with prefix.
+// Only complete lines are written, while holding mu, so that lines of concurrent writers are not mixed.
+type prefixWriter struct {
+	mu     *sync.Mutex
+	out    io.Writer
+	prefix string
+	buf    []byte
+}
+
+func (o *prefixWriter) Write(p []byte) (int, error) {
+	o.buf = append(o.buf, p...)
+	for {
+		i := bytes.IndexByte(o.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if err := o.writeLine(o.buf[:i+1]); err != nil {
+			return 0, err
+		}
+		o.buf = o.buf[i+1:]
+	}
+}
+
+// flush writes the last incomplete line, if any
+func (o *prefixWriter) flush() {
+	if len(o.buf) == 0 {
+		return
+	}
+	if err := o.writeLine(append(o.buf, '\n')); err != nil {
+		klog.V(4).Infof("unable to write build output: %v", err)
+	}
+	o.buf = nil
+}
+
+func (o *prefixWriter) writeLine(line []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, err := fmt.Fprintf(o.out, "%s%s", o.prefix, line)
+	return err
 }
 
 // BuildPushSpecificImage build an image defined in the devfile present in devfilePath
@@ -78,12 +224,13 @@ func BuildPushSpecificImage(ctx context.Context, backend Backend, fs filesystem.
 		//revive:enable:error-strings
 	}
 
-	return buildPushImage(backend, fs, component.Image, path, push)
+	return buildPushImage(ctx, backend, fs, component.Image, path, push, log.GetStdout(), log.GetStderr(), true)
 }
 
-// buildPushImage build an image using the provided backend
+// buildPushImage build an image using the provided backend, streaming the outputs of the build to out and errOut
 // If push is true, also push the image to its registry
-func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool) error {
+// If spinners is true, the progress of the build and of the push is displayed with spinners
+func buildPushImage(ctx context.Context, backend Backend, fs filesystem.Filesystem, image *devfile.ImageComponent, devfilePath string, push bool, out, errOut io.Writer, spinners bool) error {
 	if image == nil {
 		return errors.New("image should not be nil")
 	}
@@ -94,12 +241,16 @@ func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.Im
 		msg = "Building Image: %s"
 	}
 	log.Sectionf(msg, image.ImageName)
-	err := backend.Build(fs, image, devfilePath)
+	err := runWithSpinner(spinners, "Building image locally", func() error {
+		return backend.Build(ctx, fs, image, devfilePath, out, errOut, log.IsJSON())
+	})
 	if err != nil {
 		return err
 	}
 	if push {
-		err = backend.Push(image.ImageName)
+		err = runWithSpinner(spinners, "Pushing image to container registry", func() error {
+			return backend.Push(image.ImageName, out, errOut)
+		})
 		if err != nil {
 			return err
 		}
@@ -107,6 +258,22 @@ func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.Im
 	return nil
 }
 
+// runWithSpinner runs the operation, displaying its progress with a spinner if spinner is true
+func runWithSpinner(spinner bool, msg string, operation func() error) error {
+	if !spinner {
+		return operation()
+	}
+	// We use a "No Spin" since we are outputting to stdout / stderr
+	s := log.SpinnerNoSpin(msg)
+	defer s.End(false)
+	err := operation()
+	if err != nil {
+		return err
+	}
+	s.End(true)
+	return nil
+}
+
 // SelectBackend selects the container backend to use for building and pushing images
 // It will detect podman and docker CLIs (in this order),
 // or return nil if none are present locally
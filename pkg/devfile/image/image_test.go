@@ -1,13 +1,22 @@
 package image
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"os/exec"
+	"runtime"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	gomock "github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/redhat-developer/odo/pkg/config"
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
@@ -100,16 +109,16 @@ func TestBuildPushImage(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			backend := NewMockBackend(ctrl)
 			if tt.wantBuildCalled {
-				backend.EXPECT().Build(fakeFs, tt.image, tt.devfilePath).Return(tt.BuildReturns).Times(1)
+				backend.EXPECT().Build(gomock.Any(), fakeFs, tt.image, tt.devfilePath, gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.BuildReturns).Times(1)
 			} else {
-				backend.EXPECT().Build(fakeFs, nil, tt.devfilePath).Times(0)
+				backend.EXPECT().Build(gomock.Any(), fakeFs, nil, tt.devfilePath, gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			}
 			if tt.wantPushCalled {
-				backend.EXPECT().Push(tt.image.ImageName).Return(tt.PushReturns).Times(1)
+				backend.EXPECT().Push(tt.image.ImageName, gomock.Any(), gomock.Any()).Return(tt.PushReturns).Times(1)
 			} else {
-				backend.EXPECT().Push(nil).Times(0)
+				backend.EXPECT().Push(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			}
-			err := buildPushImage(backend, fakeFs, tt.image, "", tt.push)
+			err := buildPushImage(context.Background(), backend, fakeFs, tt.image, "", tt.push, io.Discard, io.Discard, false)
 
 			if tt.wantErr != (err != nil) {
 				t.Errorf("%s: Error result wanted %v, got %v", tt.name, tt.wantErr, err != nil)
@@ -230,3 +239,160 @@ func TestSelectBackend(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildAll(t *testing.T) {
+	fakeFs := filesystem.NewFakeFs()
+	newImages := func(n int) []*devfile.ImageComponent {
+		images := make([]*devfile.ImageComponent, 0, n)
+		for i := 0; i < n; i++ {
+			images = append(images, &devfile.ImageComponent{
+				Image: devfile.Image{
+					ImageName: fmt.Sprintf("image%d", i),
+				},
+			})
+		}
+		return images
+	}
+
+	t.Run("concurrency is limited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		backend := NewMockBackend(ctrl)
+		var running, maxRunning int32
+		backend.EXPECT().Build(gomock.Any(), fakeFs, gomock.Any(), "/path", gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ filesystem.Filesystem, _ *devfile.ImageComponent, _ string, _, _ io.Writer, _ bool) error {
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					m := atomic.LoadInt32(&maxRunning)
+					if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+						break
+					}
+				}
+				time.Sleep(50 * time.Millisecond)
+				return nil
+			}).Times(6)
+
+		err := BuildAll(context.Background(), fakeFs, newImages(6), "/path", backend, 2, false, io.Discard, io.Discard)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if maxRunning != 2 {
+			t.Errorf("expected 2 builds running at the same time, got %d", maxRunning)
+		}
+	})
+
+	t.Run("output is prefixed with the image name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		backend := NewMockBackend(ctrl)
+		backend.EXPECT().Build(gomock.Any(), fakeFs, gomock.Any(), "/path", gomock.Any(), gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _ filesystem.Filesystem, image *devfile.ImageComponent, _ string, out, errOut io.Writer, _ bool) error {
+				for i := 0; i < 10; i++ {
+					fmt.Fprintf(out, "%s step %d\n", image.ImageName, i)
+				}
+				// incomplete last line
+				fmt.Fprintf(out, "%s done", image.ImageName)
+				fmt.Fprintf(errOut, "%s warning\n", image.ImageName)
+				return nil
+			}).Times(3)
+
+		var out, errOut bytes.Buffer
+		err := BuildAll(context.Background(), fakeFs, newImages(3), "/path", backend, 3, false, &out, &errOut)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		errLines := strings.Split(strings.TrimSuffix(errOut.String(), "\n"), "\n")
+		sort.Strings(errLines)
+		if diff := cmp.Diff([]string{"[image0] image0 warning", "[image1] image1 warning", "[image2] image2 warning"}, errLines); diff != "" {
+			t.Errorf("error output mismatch (-want +got):\n%s", diff)
+		}
+		lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+		if len(lines) != 33 {
+			t.Fatalf("expected 33 lines, got %d: %q", len(lines), out.String())
+		}
+		for _, line := range lines {
+			// each line is "[imageN] imageN ..."
+			var prefix, name string
+			fmt.Sscanf(line, "%s %s", &prefix, &name)
+			if prefix != "["+name+"]" {
+				t.Errorf("line %q is not prefixed with its image name", line)
+			}
+		}
+	})
+
+	t.Run("images are pushed after being built", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		backend := NewMockBackend(ctrl)
+		images := newImages(2)
+		for _, image := range images {
+			build := backend.EXPECT().Build(gomock.Any(), fakeFs, image, "/path", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			backend.EXPECT().Push(image.ImageName, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(image string, out, _ io.Writer) error {
+					fmt.Fprintf(out, "%s pushed\n", image)
+					return nil
+				}).After(build).Times(1)
+		}
+
+		var out bytes.Buffer
+		err := BuildAll(context.Background(), fakeFs, images, "/path", backend, 2, true, &out, io.Discard)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+		sort.Strings(lines)
+		if diff := cmp.Diff([]string{"[image0] image0 pushed", "[image1] image1 pushed"}, lines); diff != "" {
+			t.Errorf("push output mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("no new build after the first failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		backend := NewMockBackend(ctrl)
+		images := newImages(3)
+		backend.EXPECT().Build(gomock.Any(), fakeFs, images[0], "/path", gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("build error")).Times(1)
+		backend.EXPECT().Build(gomock.Any(), fakeFs, images[1], "/path", gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		backend.EXPECT().Build(gomock.Any(), fakeFs, images[2], "/path", gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := BuildAll(context.Background(), fakeFs, images, "/path", backend, 1, false, io.Discard, io.Discard)
+		if err == nil {
+			t.Fatal("an error was expected")
+		}
+		if !strings.Contains(err.Error(), `"image0"`) || !strings.Contains(err.Error(), "build error") {
+			t.Errorf("expected the error to reference the failed image, got %v", err)
+		}
+	})
+
+	t.Run("running builds are waited for and errors are aggregated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		backend := NewMockBackend(ctrl)
+		images := newImages(3)
+		release := make(chan struct{})
+		backend.EXPECT().Build(gomock.Any(), fakeFs, images[0], "/path", gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, filesystem.Filesystem, *devfile.ImageComponent, string, io.Writer, io.Writer, bool) error {
+				defer close(release)
+				return errors.New("error 0")
+			}).Times(1)
+		backend.EXPECT().Build(gomock.Any(), fakeFs, images[1], "/path", gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, filesystem.Filesystem, *devfile.ImageComponent, string, io.Writer, io.Writer, bool) error {
+				<-release
+				return errors.New("error 1")
+			}).Times(1)
+		backend.EXPECT().Build(gomock.Any(), fakeFs, images[2], "/path", gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := BuildAll(context.Background(), fakeFs, images, "/path", backend, 2, false, io.Discard, io.Discard)
+		if err == nil {
+			t.Fatal("an error was expected")
+		}
+		for _, want := range []string{`"image0": error 0`, `"image1": error 1`} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("expected the error to contain %q, got %v", want, err)
+			}
+		}
+	})
+}
+
+func Test_defaultBuildConcurrency(t *testing.T) {
+	got := defaultBuildConcurrency()
+	if got < 1 || got > maxDefaultBuildConcurrency || got > runtime.NumCPU() {
+		t.Errorf("expected a concurrency between 1 and min(%d, NumCPU), got %d", maxDefaultBuildConcurrency, got)
+	}
+}
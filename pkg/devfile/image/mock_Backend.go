@@ -5,6 +5,8 @@
 package image
 
 import (
+	context "context"
+	io "io"
 	reflect "reflect"
 
 	v1alpha2 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -36,31 +38,45 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 }
 
 // Build mocks base method.
-func (m *MockBackend) Build(fs filesystem.Filesystem, image *v1alpha2.ImageComponent, devfilePath string) error {
+func (m *MockBackend) Build(ctx context.Context, fs filesystem.Filesystem, image *v1alpha2.ImageComponent, devfilePath string, out, errOut io.Writer, quiet bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Build", fs, image, devfilePath)
+	ret := m.ctrl.Call(m, "Build", ctx, fs, image, devfilePath, out, errOut, quiet)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Build indicates an expected call of Build.
-func (mr *MockBackendMockRecorder) Build(fs, image, devfilePath interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) Build(ctx, fs, image, devfilePath, out, errOut, quiet interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBackend)(nil).Build), fs, image, devfilePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockBackend)(nil).Build), ctx, fs, image, devfilePath, out, errOut, quiet)
 }
 
 // Push mocks base method.
-func (m *MockBackend) Push(image string) error {
+func (m *MockBackend) Push(image string, out, errOut io.Writer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Push", image)
+	ret := m.ctrl.Call(m, "Push", image, out, errOut)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Push indicates an expected call of Push.
-func (mr *MockBackendMockRecorder) Push(image interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) Push(image, out, errOut interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockBackend)(nil).Push), image)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockBackend)(nil).Push), image, out, errOut)
+}
+
+// RemoveImage mocks base method.
+func (m *MockBackend) RemoveImage(ctx context.Context, image string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveImage", ctx, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveImage indicates an expected call of RemoveImage.
+func (mr *MockBackendMockRecorder) RemoveImage(ctx, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveImage", reflect.TypeOf((*MockBackend)(nil).RemoveImage), ctx, image)
 }
 
 // String mocks base method.
@@ -76,3 +92,18 @@ func (mr *MockBackendMockRecorder) String() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "String", reflect.TypeOf((*MockBackend)(nil).String))
 }
+
+// Version mocks base method.
+func (m *MockBackend) Version(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Version", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Version indicates an expected call of Version.
+func (mr *MockBackendMockRecorder) Version(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockBackend)(nil).Version), ctx)
+}
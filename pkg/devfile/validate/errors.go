@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 )
 
 // NoComponentsError returns an error if no component is found
@@ -14,6 +16,10 @@ func (e *NoComponentsError) Error() string {
 	return "no components present"
 }
 
+func (e *NoComponentsError) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
+
 // NoContainerComponentError returns an error if no container component is found
 type NoContainerComponentError struct {
 }
@@ -22,6 +28,10 @@ func (e *NoContainerComponentError) Error() string {
 	return fmt.Sprintf("odo requires atleast one component of type '%s' in devfile", devfilev1.ContainerComponentType)
 }
 
+func (e *NoContainerComponentError) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
+
 // UnsupportedOdoCommandError returns an error if the command is neither exec nor composite
 type UnsupportedOdoCommandError struct {
 	commandId string
@@ -30,3 +40,7 @@ type UnsupportedOdoCommandError struct {
 func (e *UnsupportedOdoCommandError) Error() string {
 	return fmt.Sprintf("command %q must be of type \"exec\" or \"composite\"", e.commandId)
 }
+
+func (e *UnsupportedOdoCommandError) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
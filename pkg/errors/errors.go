@@ -1,15 +1,58 @@
 package errors
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
 
-const loginMessage = `Please login to your server: 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
 
-odo login https://mycluster.mydomain.com
-`
+// NotLoggedInError is returned when the cluster rejects the credentials of the user (401 Unauthorized),
+// generally because the session has expired
+type NotLoggedInError struct {
+	// Server is the URL of the cluster, empty if unknown
+	Server string
+	Err    error
+}
+
+func (e *NotLoggedInError) Error() string {
+	msg := "not logged in to the cluster"
+	if e.Server != "" {
+		msg += " " + e.Server
+	}
+	msg += ", the session has expired or the credentials are invalid. Please log in again with `" + e.loginCommand() + "`"
+	if e.Err != nil {
+		msg += fmt.Sprintf(": %v", e.Err)
+	}
+	return msg
+}
+
+func (e *NotLoggedInError) Unwrap() error {
+	return e.Err
+}
+
+func (e *NotLoggedInError) Kind() Kind {
+	return KindNotLoggedIn
+}
+
+func (e *NotLoggedInError) Suggestions() []string {
+	return []string{fmt.Sprintf("Log in to the cluster with `%s` and retry", e.loginCommand())}
+}
 
-type Unauthorized struct {
+func (e *NotLoggedInError) loginCommand() string {
+	if e.Server == "" {
+		return "odo login"
+	}
+	return "odo login " + e.Server
 }
 
-func (u *Unauthorized) Error() string {
-	return fmt.Sprintf("Unauthorized to access the cluster\n%s", loginMessage)
+// AsNotLoggedIn returns err wrapped into a NotLoggedInError for server when the cluster rejected the credentials of the user
+// and err has not already been classified by a typed error. Other errors are returned unchanged.
+// server is the URL of the cluster, or empty if unknown
+func AsNotLoggedIn(err error, server string) error {
+	var kindErr KindError
+	if err == nil || errors.As(err, &kindErr) || !kerrors.IsUnauthorized(err) {
+		return err
+	}
+	return &NotLoggedInError{Server: server, Err: err}
 }
@@ -0,0 +1,135 @@
+package errors
+
+import (
+	"context"
+	"errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Kind is the category of an error, so that automation consuming odo can react to failures
+// without parsing error messages
+type Kind string
+
+const (
+	KindUnknown            Kind = "Unknown"
+	KindNotLoggedIn        Kind = "NotLoggedIn"
+	KindClusterUnreachable Kind = "ClusterUnreachable"
+	KindNotFound           Kind = "NotFound"
+	KindForbidden          Kind = "Forbidden"
+	KindConflict           Kind = "Conflict"
+	KindTimeout            Kind = "Timeout"
+	KindValidationFailed   Kind = "ValidationFailed"
+	KindInvalidKubeconfig  Kind = "InvalidKubeconfig"
+)
+
+// suggestions are the hints given to the user for some kinds of errors
+var suggestions = map[Kind][]string{
+	KindNotLoggedIn:        {"Log in to the cluster with `odo login` and retry"},
+	KindClusterUnreachable: {"Check that the cluster is running and that the current context of your kubeconfig is correct"},
+	KindForbidden:          {"Check that you have the permissions required in the namespace"},
+	KindInvalidKubeconfig:  {"Check that your kubeconfig is valid and has an active context to your cluster"},
+}
+
+// KindError is implemented by the typed errors of odo which can be classified
+type KindError interface {
+	error
+	Kind() Kind
+}
+
+// ReasonError is implemented by the typed errors which further classify the cause of the error within their kind
+type ReasonError interface {
+	error
+	Reason() string
+}
+
+// SuggestionsError is implemented by the typed errors giving suggestions to the user,
+// more specific than the ones given for their kind
+type SuggestionsError interface {
+	error
+	Suggestions() []string
+}
+
+// OdoError is the classification of an error, output in machine-readable format as an api.GenericError
+type OdoError struct {
+	Kind Kind
+	// Reason further classifies the cause of the error within its kind, when known
+	Reason  string
+	Message string
+	// Details is the message of the error which has been classified,
+	// when it has been wrapped with additional context
+	Details     string
+	Suggestions []string
+	Err         error
+}
+
+func (e *OdoError) Error() string {
+	return e.Message
+}
+
+func (e *OdoError) Unwrap() error {
+	return e.Err
+}
+
+// FromError returns the OdoError representing err.
+// The kind is determined from the typed errors in the chain of wrapped errors,
+// then from the errors returned by the cluster. Errors which cannot be classified are of kind KindUnknown.
+func FromError(err error) *OdoError {
+	if err == nil {
+		return nil
+	}
+	if odoErr, ok := err.(*OdoError); ok {
+		return odoErr
+	}
+	err = AsNotLoggedIn(err, "")
+
+	kind, cause := classify(err)
+	result := &OdoError{
+		Kind:        kind,
+		Message:     err.Error(),
+		Suggestions: suggestions[kind],
+		Err:         err,
+	}
+	if cause != "" && cause != result.Message {
+		result.Details = cause
+	}
+	var reasonErr ReasonError
+	if errors.As(err, &reasonErr) {
+		result.Reason = reasonErr.Reason()
+	}
+	var suggestionsErr SuggestionsError
+	if errors.As(err, &suggestionsErr) {
+		result.Suggestions = suggestionsErr.Suggestions()
+	}
+	return result
+}
+
+// classify returns the kind of err, and the message of the error which determined the kind
+func classify(err error) (Kind, string) {
+	var kindErr KindError
+	if errors.As(err, &kindErr) {
+		return kindErr.Kind(), kindErr.Error()
+	}
+
+	var status kerrors.APIStatus
+	if errors.As(err, &status) {
+		message := status.Status().Message
+		switch {
+		case kerrors.IsForbidden(err):
+			return KindForbidden, message
+		case kerrors.IsNotFound(err):
+			return KindNotFound, message
+		case kerrors.IsConflict(err), kerrors.IsAlreadyExists(err):
+			return KindConflict, message
+		case kerrors.IsTimeout(err), kerrors.IsServerTimeout(err):
+			return KindTimeout, message
+		case kerrors.IsInvalid(err), kerrors.IsBadRequest(err):
+			return KindValidationFailed, message
+		}
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return KindTimeout, ""
+	}
+	return KindUnknown, ""
+}
@@ -0,0 +1,146 @@
+package errors_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile/validate"
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/logs"
+	clierrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/sync"
+	"github.com/redhat-developer/odo/pkg/vars"
+)
+
+func TestFromError_typedErrors(t *testing.T) {
+	secrets := schema.GroupResource{Resource: "secrets"}
+	tests := []struct {
+		err  error
+		want odoerrors.Kind
+	}{
+		{kclient.NewNoConnectionError(), odoerrors.KindClusterUnreachable},
+		{&odoerrors.NotLoggedInError{Err: errors.New("token expired")}, odoerrors.KindNotLoggedIn},
+		{&kclient.InvalidKubeconfigError{KubeconfigReason: kclient.KubeconfigMissing, Err: errors.New("no configuration")}, odoerrors.KindInvalidKubeconfig},
+		{&kclient.DeploymentNotFoundError{}, odoerrors.KindNotFound},
+		{&kclient.ServiceNotFoundError{}, odoerrors.KindNotFound},
+		{&kclient.ServiceNotLinkableError{Name: "svc", Reason: "the service is headless"}, odoerrors.KindValidationFailed},
+		{&kclient.ResourceNotFoundError{Err: kerrors.NewNotFound(secrets, "foo")}, odoerrors.KindNotFound},
+		{&kclient.ResourceForbiddenError{Err: kerrors.NewForbidden(secrets, "foo", errors.New("denied"))}, odoerrors.KindForbidden},
+		{podman.NewPodmanNotFoundError(nil), odoerrors.KindNotFound},
+		{&platform.PodNotFoundError{}, odoerrors.KindNotFound},
+		{&validate.NoComponentsError{}, odoerrors.KindValidationFailed},
+		{&validate.NoContainerComponentError{}, odoerrors.KindValidationFailed},
+		{&validate.UnsupportedOdoCommandError{}, odoerrors.KindValidationFailed},
+		{vars.NewErrBadKey("bad"), odoerrors.KindValidationFailed},
+		{component.NewNoComponentFoundError("comp", "ns"), odoerrors.KindNotFound},
+		{logs.InvalidModeError{}, odoerrors.KindValidationFailed},
+		{libdevfile.NewNoCommandFoundError("run", ""), odoerrors.KindNotFound},
+		{libdevfile.NewNoDefaultCommandFoundError("run"), odoerrors.KindNotFound},
+		{libdevfile.NewMoreThanOneDefaultCommandFoundError("run"), odoerrors.KindValidationFailed},
+		{libdevfile.NewComponentNotExistError("comp"), odoerrors.KindNotFound},
+		{libdevfile.NewComponentsWithSameNameError("comp"), odoerrors.KindValidationFailed},
+		{libdevfile.NewComponentTypeNotFoundError("container"), odoerrors.KindNotFound},
+		{libdevfile.NoCommandForGroup{Group: "run"}, odoerrors.KindNotFound},
+		{clierrors.NewNoCommandInDevfileError("run"), odoerrors.KindNotFound},
+		{clierrors.NewNoCommandNameInDevfileError("cmd"), odoerrors.KindNotFound},
+		{genericclioptions.NewNoDevfileError(t.TempDir()), odoerrors.KindNotFound},
+		{state.NewErrAlreadyRunningOnPlatform("cluster", 1), odoerrors.KindConflict},
+		{&sync.RemotePathNotFoundError{Path: "/tmp/missing"}, odoerrors.KindNotFound},
+		{preference.NewMinimumDurationValueError(), odoerrors.KindValidationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%T", tt.err), func(t *testing.T) {
+			got := odoerrors.FromError(tt.err)
+			if got.Kind != tt.want {
+				t.Errorf("expected kind %q, got %q", tt.want, got.Kind)
+			}
+			if got.Message != tt.err.Error() {
+				t.Errorf("expected message %q, got %q", tt.err.Error(), got.Message)
+			}
+			if got.Details != "" {
+				t.Errorf("expected no details for an error not wrapped, got %q", got.Details)
+			}
+
+			// the kind is also found when the error is wrapped
+			wrapped := odoerrors.FromError(fmt.Errorf("unable to run the command: %w", tt.err))
+			if wrapped.Kind != tt.want {
+				t.Errorf("expected kind %q for wrapped error, got %q", tt.want, wrapped.Kind)
+			}
+			if wrapped.Details != tt.err.Error() {
+				t.Errorf("expected details %q for wrapped error, got %q", tt.err.Error(), wrapped.Details)
+			}
+		})
+	}
+}
+
+func TestFromError_otherErrors(t *testing.T) {
+	gr := schema.GroupResource{Resource: "pods"}
+	tests := []struct {
+		name string
+		err  error
+		want odoerrors.Kind
+	}{
+		{name: "unauthorized", err: kerrors.NewUnauthorized("no token"), want: odoerrors.KindNotLoggedIn},
+		{name: "forbidden", err: kerrors.NewForbidden(gr, "foo", errors.New("denied")), want: odoerrors.KindForbidden},
+		{name: "not found", err: kerrors.NewNotFound(gr, "foo"), want: odoerrors.KindNotFound},
+		{name: "conflict", err: kerrors.NewConflict(gr, "foo", errors.New("modified")), want: odoerrors.KindConflict},
+		{name: "already exists", err: kerrors.NewAlreadyExists(gr, "foo"), want: odoerrors.KindConflict},
+		{name: "server timeout", err: kerrors.NewServerTimeout(gr, "get", 1), want: odoerrors.KindTimeout},
+		{name: "bad request", err: kerrors.NewBadRequest("bad"), want: odoerrors.KindValidationFailed},
+		{name: "deadline exceeded", err: fmt.Errorf("waiting for pod: %w", context.DeadlineExceeded), want: odoerrors.KindTimeout},
+		{name: "internal error", err: kerrors.NewInternalError(errors.New("boom")), want: odoerrors.KindUnknown},
+		{name: "unknown error", err: errors.New("something went wrong"), want: odoerrors.KindUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := odoerrors.FromError(tt.err)
+			if got.Kind != tt.want {
+				t.Errorf("expected kind %q, got %q", tt.want, got.Kind)
+			}
+			if !errors.Is(got, tt.err) {
+				t.Errorf("the original error should be wrapped")
+			}
+		})
+	}
+
+	if odoerrors.FromError(nil) != nil {
+		t.Errorf("expected nil for a nil error")
+	}
+}
+
+func TestAsNotLoggedIn(t *testing.T) {
+	server := "https://api.example.com:6443"
+	err := odoerrors.AsNotLoggedIn(fmt.Errorf("unable to list pods: %w", kerrors.NewUnauthorized("token expired")), server)
+
+	odoErr := odoerrors.FromError(err)
+	if odoErr.Kind != odoerrors.KindNotLoggedIn {
+		t.Errorf("expected kind %q, got %q", odoerrors.KindNotLoggedIn, odoErr.Kind)
+	}
+	if !strings.Contains(odoErr.Message, server) {
+		t.Errorf("expected the message to contain the server, got %q", odoErr.Message)
+	}
+	wantSuggestions := []string{"Log in to the cluster with `odo login " + server + "` and retry"}
+	if diff := cmp.Diff(wantSuggestions, odoErr.Suggestions); diff != "" {
+		t.Errorf("suggestions mismatch (-want +got):\n%s", diff)
+	}
+
+	forbidden := kerrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", errors.New("denied"))
+	if got := odoerrors.AsNotLoggedIn(forbidden, server); got != forbidden {
+		t.Errorf("expected other errors to be returned unchanged, got %v", got)
+	}
+}
@@ -45,7 +45,7 @@ func (o fakePlatform) GetRunningPodFromSelector(selector string) (*corev1.Pod, e
 	panic("not implemented yet")
 }
 
-func (o fakePlatform) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
+func (o fakePlatform) GetPodUsingComponentName(componentName string, appName string) (*corev1.Pod, error) {
 	panic("not implemented yet")
 }
 
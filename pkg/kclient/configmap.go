@@ -17,7 +17,11 @@ func (c *Client) ListConfigMaps(labelSelector string) ([]corev1.ConfigMap, error
 		}
 	}
 
-	cmList, err := c.KubeClient.CoreV1().ConfigMaps(c.Namespace).List(context.TODO(), listOptions)
+	var cmList *corev1.ConfigMapList
+	err := c.retryTransient(func() (err error) {
+		cmList, err = c.KubeClient.CoreV1().ConfigMaps(c.Namespace).List(context.TODO(), listOptions)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to get configmap list: %w", err)
 	}
@@ -29,7 +29,11 @@ const (
 
 // GetDeploymentByName gets a deployment by querying by name
 func (c *Client) GetDeploymentByName(name string) (*appsv1.Deployment, error) {
-	deployment, err := c.KubeClient.AppsV1().Deployments(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	var deployment *appsv1.Deployment
+	err := c.retryTransient(func() (err error) {
+		deployment, err = c.KubeClient.AppsV1().Deployments(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		return err
+	})
 	// TODO(pvala): Figure out why Kind and APIVersion are not added to the deployment object
 	deployment.APIVersion = DeploymentAPIVersion
 	deployment.Kind = DeploymentKind
@@ -64,18 +68,20 @@ func (c *Client) GetOneDeploymentFromSelector(selector string) (*appsv1.Deployme
 
 // GetDeploymentFromSelector returns an array of Deployment resources which match the given selector
 func (c *Client) GetDeploymentFromSelector(selector string) ([]appsv1.Deployment, error) {
-	var deploymentList *appsv1.DeploymentList
-	var err error
-
-	if selector != "" {
-		deploymentList, err = c.KubeClient.AppsV1().Deployments(c.Namespace).List(context.TODO(), metav1.ListOptions{
-			LabelSelector: selector,
-		})
-	} else {
-		deploymentList, err = c.KubeClient.AppsV1().Deployments(c.Namespace).List(context.TODO(), metav1.ListOptions{
+	listOptions := metav1.ListOptions{
+		LabelSelector: selector,
+	}
+	if selector == "" {
+		listOptions = metav1.ListOptions{
 			FieldSelector: fields.Set{"metadata.namespace": c.Namespace}.AsSelector().String(),
-		})
+		}
 	}
+
+	var deploymentList *appsv1.DeploymentList
+	err := c.retryTransient(func() (err error) {
+		deploymentList, err = c.KubeClient.AppsV1().Deployments(c.Namespace).List(context.TODO(), listOptions)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to list Deployments: %w", err)
 	}
@@ -73,7 +73,11 @@ func (c *Client) ListDynamicResources(namespace string, gvr schema.GroupVersionR
 		listOptions.LabelSelector = selector
 	}
 
-	list, err := c.DynamicClient.Resource(gvr).Namespace(ns).List(context.TODO(), listOptions)
+	var list *unstructured.UnstructuredList
+	err := c.retryTransient(func() (err error) {
+		list, err = c.DynamicClient.Resource(gvr).Namespace(ns).List(context.TODO(), listOptions)
+		return err
+	})
 	if err != nil {
 		if kerrors.IsNotFound(err) {
 			// Assume this is a cluster scoped resource (not namespace scoped) and skip it
@@ -99,7 +103,11 @@ func (c *Client) ListClusterWideDynamicResources(gvr schema.GroupVersionResource
 		listOptions.LabelSelector = selector
 	}
 
-	list, err := c.DynamicClient.Resource(gvr).List(context.TODO(), listOptions)
+	var list *unstructured.UnstructuredList
+	err := c.retryTransient(func() (err error) {
+		list, err = c.DynamicClient.Resource(gvr).List(context.TODO(), listOptions)
+		return err
+	})
 	if err != nil {
 		if kerrors.IsNotFound(err) {
 			return &unstructured.UnstructuredList{}, nil
@@ -112,7 +120,11 @@ func (c *Client) ListClusterWideDynamicResources(gvr schema.GroupVersionResource
 
 // GetDynamicResource returns an unstructured instance of a Custom Resource currently deployed in the active namespace
 func (c *Client) GetDynamicResource(gvr schema.GroupVersionResource, name string) (*unstructured.Unstructured, error) {
-	res, err := c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	var res *unstructured.Unstructured
+	err := c.retryTransient(func() (err error) {
+		res, err = c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -217,10 +229,12 @@ func (c *Client) WaitDynamicResourceDeleted(gvr schema.GroupVersionResource, nam
 		return err
 	}
 
+	timeout := c.timeouts().ResourceDeletion
+	timeoutCh := time.After(timeout)
 	for {
 		select {
-		case <-time.After(time.Minute):
-			return fmt.Errorf("timeout while waiting for %q resource to be deleted", name)
+		case <-timeoutCh:
+			return fmt.Errorf("waited %s but %q resource was not deleted in time", timeout, name)
 
 		case val, ok := <-watcher.ResultChan():
 			if !ok {
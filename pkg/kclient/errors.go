@@ -1,6 +1,13 @@
 package kclient
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
+)
 
 // DeploymentNotFoundError returns an error if no deployment is found with the selector
 type DeploymentNotFoundError struct {
@@ -11,6 +18,10 @@ func (e *DeploymentNotFoundError) Error() string {
 	return fmt.Sprintf("deployment not found for the selector: %s", e.Selector)
 }
 
+func (e *DeploymentNotFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
 // ServiceNotFoundError returns an error if no service is found with the selector
 type ServiceNotFoundError struct {
 	Selector string
@@ -20,6 +31,24 @@ func (e *ServiceNotFoundError) Error() string {
 	return fmt.Sprintf("service not found for the selector %q", e.Selector)
 }
 
+func (e *ServiceNotFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
+// ServiceNotLinkableError is returned when the Service of a component cannot be used by other components to reach it
+type ServiceNotLinkableError struct {
+	Name   string
+	Reason string
+}
+
+func (e *ServiceNotLinkableError) Error() string {
+	return fmt.Sprintf("service %q cannot be linked: %s", e.Name, e.Reason)
+}
+
+func (e *ServiceNotLinkableError) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
+
 type NoConnectionError struct{}
 
 func NewNoConnectionError() NoConnectionError {
@@ -30,3 +59,149 @@ func (e NoConnectionError) Error() string {
 	// could also be "cluster is non accessible"
 	return "unable to access the cluster"
 }
+
+func (e NoConnectionError) Kind() odoerrors.Kind {
+	return odoerrors.KindClusterUnreachable
+}
+
+// KubeconfigErrorReason classifies the errors returned when loading the kubeconfig
+type KubeconfigErrorReason string
+
+const (
+	// KubeconfigMissing indicates that no kubeconfig file, or an empty one, has been found
+	KubeconfigMissing KubeconfigErrorReason = "KubeconfigMissing"
+	// KubeconfigMalformed indicates that a kubeconfig file cannot be parsed
+	KubeconfigMalformed KubeconfigErrorReason = "KubeconfigMalformed"
+	// KubeconfigContextNotFound indicates that no context is selected, or that the selected context does not exist
+	KubeconfigContextNotFound KubeconfigErrorReason = "ContextNotFound"
+	// KubeconfigCertificateExpired indicates that the client certificate of the current user has expired
+	KubeconfigCertificateExpired KubeconfigErrorReason = "CertificateExpired"
+	// KubeconfigInvalid indicates any other invalid configuration
+	KubeconfigInvalid KubeconfigErrorReason = "InvalidConfiguration"
+)
+
+// InvalidKubeconfigError is returned when the client configuration cannot be loaded from the kubeconfig
+type InvalidKubeconfigError struct {
+	KubeconfigReason KubeconfigErrorReason
+	// Server is the server of the current context, if known
+	Server string
+	Err    error
+}
+
+func (e *InvalidKubeconfigError) Error() string {
+	return fmt.Sprintf("%s\n%s\nError: %v", e.description(), strings.Join(e.Suggestions(), "\n"), e.Err)
+}
+
+func (e *InvalidKubeconfigError) Unwrap() error {
+	return e.Err
+}
+
+func (e *InvalidKubeconfigError) Kind() odoerrors.Kind {
+	return odoerrors.KindInvalidKubeconfig
+}
+
+func (e *InvalidKubeconfigError) Reason() string {
+	return string(e.KubeconfigReason)
+}
+
+// Suggestions returns the hints given to the user to fix the configuration
+func (e *InvalidKubeconfigError) Suggestions() []string {
+	server := e.Server
+	if server == "" {
+		server = "<cluster-url>"
+	}
+	switch e.KubeconfigReason {
+	case KubeconfigMissing:
+		return []string{
+			"Please ensure you have an active kubernetes context to your cluster.",
+			fmt.Sprintf("Log in with `odo login %s`, or set the KUBECONFIG environment variable to the path of your kubeconfig file.", server),
+		}
+	case KubeconfigMalformed:
+		return []string{"Fix the syntax of the kubeconfig file, or regenerate it by logging in to your cluster again."}
+	case KubeconfigContextNotFound:
+		return []string{"Select an existing context with `kubectl config use-context <context>`, or log in to your cluster again."}
+	case KubeconfigCertificateExpired:
+		return []string{fmt.Sprintf("Renew your client certificate, or log in again with `odo login %s`.", server)}
+	default:
+		return []string{
+			"Please ensure you have an active kubernetes context to your cluster.",
+			"Consult your Kubernetes distribution's documentation for more details.",
+		}
+	}
+}
+
+func (e *InvalidKubeconfigError) description() string {
+	switch e.KubeconfigReason {
+	case KubeconfigMissing:
+		return "No kubeconfig has been found."
+	case KubeconfigMalformed:
+		return "The kubeconfig file cannot be parsed."
+	case KubeconfigContextNotFound:
+		return "The current context of the kubeconfig does not exist."
+	case KubeconfigCertificateExpired:
+		if e.Server != "" {
+			return fmt.Sprintf("The client certificate used to access the cluster %s has expired.", e.Server)
+		}
+		return "The client certificate used to access the cluster has expired."
+	default:
+		if e.Server != "" {
+			return fmt.Sprintf("The kubeconfig used to access the cluster %s is invalid.", e.Server)
+		}
+		return "The kubeconfig is invalid."
+	}
+}
+
+// ResourceNotFoundError is returned when a resource does not exist in the namespace
+type ResourceNotFoundError struct {
+	Resource  string
+	Name      string
+	Namespace string
+	Err       error
+}
+
+func (e *ResourceNotFoundError) Error() string {
+	return fmt.Sprintf("%s %q not found in namespace %q", e.Resource, e.Name, e.Namespace)
+}
+
+func (e *ResourceNotFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
+func (e *ResourceNotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// ResourceForbiddenError is returned when the user is not allowed to access a resource in the namespace
+type ResourceForbiddenError struct {
+	Resource  string
+	Name      string
+	Namespace string
+	Err       error
+}
+
+func (e *ResourceForbiddenError) Error() string {
+	return fmt.Sprintf("access to %s %q in namespace %q is forbidden: %v", e.Resource, e.Name, e.Namespace, e.Err)
+}
+
+func (e *ResourceForbiddenError) Kind() odoerrors.Kind {
+	return odoerrors.KindForbidden
+}
+
+func (e *ResourceForbiddenError) Unwrap() error {
+	return e.Err
+}
+
+// newResourceError wraps the error returned by the cluster when doing action on a resource.
+// NotFound and Forbidden errors are returned as ResourceNotFoundError and ResourceForbiddenError,
+// other errors (transport errors, etc) are wrapped with the action, type, name and namespace of the resource.
+// The original error can still be checked with the kerrors.IsXXX functions.
+func newResourceError(action, resource, name, namespace string, err error) error {
+	switch {
+	case kerrors.IsNotFound(err):
+		return &ResourceNotFoundError{Resource: resource, Name: name, Namespace: namespace, Err: err}
+	case kerrors.IsForbidden(err):
+		return &ResourceForbiddenError{Resource: resource, Name: name, Namespace: namespace, Err: err}
+	default:
+		return fmt.Errorf("unable to %s the %s %q in namespace %q: %w", action, resource, name, namespace, err)
+	}
+}
@@ -20,5 +20,10 @@ func (c *Client) ListIngresses(namespace, selector string) (*v1.IngressList, err
 	if namespace == "" {
 		namespace = c.Namespace
 	}
-	return c.KubeClient.NetworkingV1().Ingresses(namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	var ingresses *v1.IngressList
+	err := c.retryTransient(func() (err error) {
+		ingresses, err = c.KubeClient.NetworkingV1().Ingresses(namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+		return err
+	})
+	return ingresses, err
 }
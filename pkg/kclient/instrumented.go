@@ -0,0 +1,809 @@
+package kclient
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/go-openapi/spec"
+	projectv1 "github.com/openshift/api/project/v1"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	psaApi "k8s.io/pod-security-admission/api"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	bindingApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
+	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
+)
+
+// instrumentedClient is a ClientInterface observing each call to the methods of the decorated client
+// with a MetricsRecorder, under the name of the method
+type instrumentedClient struct {
+	client   ClientInterface
+	recorder MetricsRecorder
+}
+
+var _ ClientInterface = (*instrumentedClient)(nil)
+
+// NewInstrumentedClient returns a ClientInterface calling the methods of client, and observing each call with recorder.
+// client is returned as is if recorder is nil
+func NewInstrumentedClient(client ClientInterface, recorder MetricsRecorder) ClientInterface {
+	if recorder == nil {
+		return client
+	}
+	return &instrumentedClient{
+		client:   client,
+		recorder: recorder,
+	}
+}
+
+func (o *instrumentedClient) observe(name string, start time.Time, err error) {
+	o.recorder.ObserveOperation(name, time.Since(start), err)
+}
+
+func (o *instrumentedClient) ExecCMDInContainer(ctx context.Context, containerName, podName string, cmd []string, stdout, stderr io.Writer, stdin io.Reader, tty bool) error {
+	start := time.Now()
+	err := o.client.ExecCMDInContainer(ctx, containerName, podName, cmd, stdout, stderr, stdin, tty)
+	o.observe("ExecCMDInContainer", start, err)
+	return err
+}
+
+func (o *instrumentedClient) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+	start := time.Now()
+	result, err := o.client.GetPodLogs(podName, containerName, followLog)
+	o.observe("GetPodLogs", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	start := time.Now()
+	result, err := o.client.GetPodsMatchingSelector(selector)
+	o.observe("GetPodsMatchingSelector", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetAllResourcesFromSelector(selector string, ns string) ([]unstructured.Unstructured, error) {
+	start := time.Now()
+	result, err := o.client.GetAllResourcesFromSelector(selector, ns)
+	o.observe("GetAllResourcesFromSelector", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
+	start := time.Now()
+	result, err := o.client.GetAllPodsInNamespaceMatchingSelector(selector, ns)
+	o.observe("GetAllPodsInNamespaceMatchingSelector", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	start := time.Now()
+	result, err := o.client.GetRunningPodFromSelector(selector)
+	o.observe("GetRunningPodFromSelector", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetPodUsingComponentName(componentName string, appName string) (*corev1.Pod, error) {
+	start := time.Now()
+	result, err := o.client.GetPodUsingComponentName(componentName, appName)
+	o.observe("GetPodUsingComponentName", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) PodWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	start := time.Now()
+	result, err := o.client.PodWatcher(ctx, selector)
+	o.observe("PodWatcher", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) IsServiceBindingSupported() (bool, error) {
+	start := time.Now()
+	result, err := o.client.IsServiceBindingSupported()
+	o.observe("IsServiceBindingSupported", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetBindableKinds() (bindingApi.BindableKinds, error) {
+	start := time.Now()
+	result, err := o.client.GetBindableKinds()
+	o.observe("GetBindableKinds", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetBindableKindStatusRestMapping(bindableKindStatuses []bindingApi.BindableKindsStatus) ([]*meta.RESTMapping, error) {
+	start := time.Now()
+	result, err := o.client.GetBindableKindStatusRestMapping(bindableKindStatuses)
+	o.observe("GetBindableKindStatusRestMapping", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetBindingServiceBinding(name string) (bindingApi.ServiceBinding, error) {
+	start := time.Now()
+	result, err := o.client.GetBindingServiceBinding(name)
+	o.observe("GetBindingServiceBinding", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetSpecServiceBinding(name string) (specApi.ServiceBinding, error) {
+	start := time.Now()
+	result, err := o.client.GetSpecServiceBinding(name)
+	o.observe("GetSpecServiceBinding", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) ListServiceBindingsFromAllGroups() ([]specApi.ServiceBinding, []bindingApi.ServiceBinding, error) {
+	start := time.Now()
+	result0, result1, err := o.client.ListServiceBindingsFromAllGroups()
+	o.observe("ListServiceBindingsFromAllGroups", start, err)
+	return result0, result1, err
+}
+
+func (o *instrumentedClient) NewServiceBindingServiceObject(serviceNs string, unstructuredService unstructured.Unstructured, bindingName string) (bindingApi.Service, error) {
+	start := time.Now()
+	result, err := o.client.NewServiceBindingServiceObject(serviceNs, unstructuredService, bindingName)
+	o.observe("NewServiceBindingServiceObject", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetWorkloadKinds() ([]string, []schema.GroupVersionKind, error) {
+	start := time.Now()
+	result0, result1, err := o.client.GetWorkloadKinds()
+	o.observe("GetWorkloadKinds", start, err)
+	return result0, result1, err
+}
+
+func (o *instrumentedClient) ListConfigMaps(labelSelector string) ([]corev1.ConfigMap, error) {
+	start := time.Now()
+	result, err := o.client.ListConfigMaps(labelSelector)
+	o.observe("ListConfigMaps", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetDeploymentByName(name string) (*appsv1.Deployment, error) {
+	start := time.Now()
+	result, err := o.client.GetDeploymentByName(name)
+	o.observe("GetDeploymentByName", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetOneDeployment(componentName, appName string, isPartOfComponent bool) (*appsv1.Deployment, error) {
+	start := time.Now()
+	result, err := o.client.GetOneDeployment(componentName, appName, isPartOfComponent)
+	o.observe("GetOneDeployment", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetOneDeploymentFromSelector(selector string) (*appsv1.Deployment, error) {
+	start := time.Now()
+	result, err := o.client.GetOneDeploymentFromSelector(selector)
+	o.observe("GetOneDeploymentFromSelector", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetDeploymentFromSelector(selector string) ([]appsv1.Deployment, error) {
+	start := time.Now()
+	result, err := o.client.GetDeploymentFromSelector(selector)
+	o.observe("GetDeploymentFromSelector", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) CreateDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error) {
+	start := time.Now()
+	result, err := o.client.CreateDeployment(deploy)
+	o.observe("CreateDeployment", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) UpdateDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error) {
+	start := time.Now()
+	result, err := o.client.UpdateDeployment(deploy)
+	o.observe("UpdateDeployment", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) ApplyDeployment(deploy appsv1.Deployment) (*appsv1.Deployment, error) {
+	start := time.Now()
+	result, err := o.client.ApplyDeployment(deploy)
+	o.observe("ApplyDeployment", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetDeploymentAPIVersion() (schema.GroupVersionKind, error) {
+	start := time.Now()
+	result, err := o.client.GetDeploymentAPIVersion()
+	o.observe("GetDeploymentAPIVersion", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) IsDeploymentExtensionsV1Beta1() (bool, error) {
+	start := time.Now()
+	result, err := o.client.IsDeploymentExtensionsV1Beta1()
+	o.observe("IsDeploymentExtensionsV1Beta1", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) DeploymentWatcher(ctx context.Context, selector string) (watch.Interface, error) {
+	start := time.Now()
+	result, err := o.client.DeploymentWatcher(ctx, selector)
+	o.observe("DeploymentWatcher", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) PatchDynamicResource(exampleCustomResource unstructured.Unstructured) (bool, error) {
+	start := time.Now()
+	result, err := o.client.PatchDynamicResource(exampleCustomResource)
+	o.observe("PatchDynamicResource", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) ListDynamicResources(namespace string, gvr schema.GroupVersionResource, selector string) (*unstructured.UnstructuredList, error) {
+	start := time.Now()
+	result, err := o.client.ListDynamicResources(namespace, gvr, selector)
+	o.observe("ListDynamicResources", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetDynamicResource(gvr schema.GroupVersionResource, name string) (*unstructured.Unstructured, error) {
+	start := time.Now()
+	result, err := o.client.GetDynamicResource(gvr, name)
+	o.observe("GetDynamicResource", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) UpdateDynamicResource(gvr schema.GroupVersionResource, name string, u *unstructured.Unstructured) error {
+	start := time.Now()
+	err := o.client.UpdateDynamicResource(gvr, name, u)
+	o.observe("UpdateDynamicResource", start, err)
+	return err
+}
+
+func (o *instrumentedClient) DeleteDynamicResource(name string, gvr schema.GroupVersionResource, wait bool) error {
+	start := time.Now()
+	err := o.client.DeleteDynamicResource(name, gvr, wait)
+	o.observe("DeleteDynamicResource", start, err)
+	return err
+}
+
+func (o *instrumentedClient) PodWarningEventWatcher(ctx context.Context) (watch.Interface, bool, error) {
+	start := time.Now()
+	result0, result1, err := o.client.PodWarningEventWatcher(ctx)
+	o.observe("PodWarningEventWatcher", start, err)
+	return result0, result1, err
+}
+
+func (o *instrumentedClient) GetClient() kubernetes.Interface {
+	start := time.Now()
+	result := o.client.GetClient()
+	o.observe("GetClient", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) GetConfig() clientcmd.ClientConfig {
+	start := time.Now()
+	result := o.client.GetConfig()
+	o.observe("GetConfig", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) GetClientConfig() *rest.Config {
+	start := time.Now()
+	result := o.client.GetClientConfig()
+	o.observe("GetClientConfig", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) GetDynamicClient() dynamic.Interface {
+	start := time.Now()
+	result := o.client.GetDynamicClient()
+	o.observe("GetDynamicClient", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) GeneratePortForwardReq(podName string) *rest.Request {
+	start := time.Now()
+	result := o.client.GeneratePortForwardReq(podName)
+	o.observe("GeneratePortForwardReq", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) SetDiscoveryInterface(client discovery.DiscoveryInterface) {
+	start := time.Now()
+	o.client.SetDiscoveryInterface(client)
+	o.observe("SetDiscoveryInterface", start, nil)
+}
+
+func (o *instrumentedClient) IsResourceSupported(apiGroup, apiVersion, resourceName string) (bool, error) {
+	start := time.Now()
+	result, err := o.client.IsResourceSupported(apiGroup, apiVersion, resourceName)
+	o.observe("IsResourceSupported", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) IsSSASupported() bool {
+	start := time.Now()
+	result := o.client.IsSSASupported()
+	o.observe("IsSSASupported", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) Refresh() (bool, error) {
+	start := time.Now()
+	result, err := o.client.Refresh()
+	o.observe("Refresh", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetCurrentNamespace() string {
+	start := time.Now()
+	result := o.client.GetCurrentNamespace()
+	o.observe("GetCurrentNamespace", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) SetNamespace(ns string) {
+	start := time.Now()
+	o.client.SetNamespace(ns)
+	o.observe("SetNamespace", start, nil)
+}
+
+func (o *instrumentedClient) GetNamespaces() ([]string, error) {
+	start := time.Now()
+	result, err := o.client.GetNamespaces()
+	o.observe("GetNamespaces", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetNamespace(name string) (*corev1.Namespace, error) {
+	start := time.Now()
+	result, err := o.client.GetNamespace(name)
+	o.observe("GetNamespace", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetNamespaceNormal(name string) (*corev1.Namespace, error) {
+	start := time.Now()
+	result, err := o.client.GetNamespaceNormal(name)
+	o.observe("GetNamespaceNormal", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) CreateNamespace(name string) (*corev1.Namespace, error) {
+	start := time.Now()
+	result, err := o.client.CreateNamespace(name)
+	o.observe("CreateNamespace", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) DeleteNamespace(name string, wait bool) error {
+	start := time.Now()
+	err := o.client.DeleteNamespace(name, wait)
+	o.observe("DeleteNamespace", start, err)
+	return err
+}
+
+func (o *instrumentedClient) SetCurrentNamespace(namespace string) error {
+	start := time.Now()
+	err := o.client.SetCurrentNamespace(namespace)
+	o.observe("SetCurrentNamespace", start, err)
+	return err
+}
+
+func (o *instrumentedClient) WaitForServiceAccountInNamespace(namespace, serviceAccountName string) error {
+	start := time.Now()
+	err := o.client.WaitForServiceAccountInNamespace(namespace, serviceAccountName)
+	o.observe("WaitForServiceAccountInNamespace", start, err)
+	return err
+}
+
+func (o *instrumentedClient) GetCurrentNamespacePolicy() (psaApi.Policy, error) {
+	start := time.Now()
+	result, err := o.client.GetCurrentNamespacePolicy()
+	o.observe("GetCurrentNamespacePolicy", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) TokenValidUntil() (time.Time, error) {
+	start := time.Now()
+	result, err := o.client.TokenValidUntil()
+	o.observe("TokenValidUntil", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetServerVersion(timeout time.Duration) (*ServerInfo, error) {
+	start := time.Now()
+	result, err := o.client.GetServerVersion(timeout)
+	o.observe("GetServerVersion", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetOCVersion() (string, error) {
+	start := time.Now()
+	result, err := o.client.GetOCVersion()
+	o.observe("GetOCVersion", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) IsCSVSupported() (bool, error) {
+	start := time.Now()
+	result, err := o.client.IsCSVSupported()
+	o.observe("IsCSVSupported", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) ListClusterServiceVersions() (*olm.ClusterServiceVersionList, error) {
+	start := time.Now()
+	result, err := o.client.ListClusterServiceVersions()
+	o.observe("ListClusterServiceVersions", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetCustomResourcesFromCSV(csv *olm.ClusterServiceVersion) *[]olm.CRDDescription {
+	start := time.Now()
+	result := o.client.GetCustomResourcesFromCSV(csv)
+	o.observe("GetCustomResourcesFromCSV", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) GetCSVWithCR(name string) (*olm.ClusterServiceVersion, error) {
+	start := time.Now()
+	result, err := o.client.GetCSVWithCR(name)
+	o.observe("GetCSVWithCR", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetResourceSpecDefinition(group, version, kind string) (*spec.Schema, error) {
+	start := time.Now()
+	result, err := o.client.GetResourceSpecDefinition(group, version, kind)
+	o.observe("GetResourceSpecDefinition", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetRestMappingFromUnstructured(u unstructured.Unstructured) (*meta.RESTMapping, error) {
+	start := time.Now()
+	result, err := o.client.GetRestMappingFromUnstructured(u)
+	o.observe("GetRestMappingFromUnstructured", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetRestMappingFromGVK(gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	start := time.Now()
+	result, err := o.client.GetRestMappingFromGVK(gvk)
+	o.observe("GetRestMappingFromGVK", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetOperatorGVRList() ([]meta.RESTMapping, error) {
+	start := time.Now()
+	result, err := o.client.GetOperatorGVRList()
+	o.observe("GetOperatorGVRList", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetGVKFromGVR(gvr schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	start := time.Now()
+	result, err := o.client.GetGVKFromGVR(gvr)
+	o.observe("GetGVKFromGVR", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetGVRFromGVK(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	start := time.Now()
+	result, err := o.client.GetGVRFromGVK(gvk)
+	o.observe("GetGVRFromGVK", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) TryWithBlockOwnerDeletion(ownerReference metav1.OwnerReference, exec func(ownerReference metav1.OwnerReference) error) error {
+	start := time.Now()
+	err := o.client.TryWithBlockOwnerDeletion(ownerReference, exec)
+	o.observe("TryWithBlockOwnerDeletion", start, err)
+	return err
+}
+
+func (o *instrumentedClient) IsPodNameMatchingSelector(ctx context.Context, podname string, selector string) (bool, error) {
+	start := time.Now()
+	result, err := o.client.IsPodNameMatchingSelector(ctx, podname, selector)
+	o.observe("IsPodNameMatchingSelector", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}, address string) error {
+	start := time.Now()
+	err := o.client.SetupPortForwarding(pod, portPairs, out, errOut, stopChan, address)
+	o.observe("SetupPortForwarding", start, err)
+	return err
+}
+
+func (o *instrumentedClient) CreateNewProject(projectName string, wait bool) error {
+	start := time.Now()
+	err := o.client.CreateNewProject(projectName, wait)
+	o.observe("CreateNewProject", start, err)
+	return err
+}
+
+func (o *instrumentedClient) DeleteProject(name string, wait bool) error {
+	start := time.Now()
+	err := o.client.DeleteProject(name, wait)
+	o.observe("DeleteProject", start, err)
+	return err
+}
+
+func (o *instrumentedClient) GetCurrentProjectName() string {
+	start := time.Now()
+	result := o.client.GetCurrentProjectName()
+	o.observe("GetCurrentProjectName", start, nil)
+	return result
+}
+
+func (o *instrumentedClient) GetProject(projectName string) (*projectv1.Project, error) {
+	start := time.Now()
+	result, err := o.client.GetProject(projectName)
+	o.observe("GetProject", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) IsProjectSupported() (bool, error) {
+	start := time.Now()
+	result, err := o.client.IsProjectSupported()
+	o.observe("IsProjectSupported", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) ListProjectNames() ([]string, error) {
+	start := time.Now()
+	result, err := o.client.ListProjectNames()
+	o.observe("ListProjectNames", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) CreateTLSSecret(tlsCertificate []byte, tlsPrivKey []byte, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+	start := time.Now()
+	result, err := o.client.CreateTLSSecret(tlsCertificate, tlsPrivKey, objectMeta)
+	o.observe("CreateTLSSecret", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetSecret(name, namespace string) (*corev1.Secret, error) {
+	start := time.Now()
+	result, err := o.client.GetSecret(name, namespace)
+	o.observe("GetSecret", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) UpdateSecret(secret *corev1.Secret, namespace string) (*corev1.Secret, error) {
+	start := time.Now()
+	result, err := o.client.UpdateSecret(secret, namespace)
+	o.observe("UpdateSecret", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) DeleteSecret(secretName, namespace string) error {
+	start := time.Now()
+	err := o.client.DeleteSecret(secretName, namespace)
+	o.observe("DeleteSecret", start, err)
+	return err
+}
+
+func (o *instrumentedClient) CreateSecret(objectMeta metav1.ObjectMeta, data map[string]string, ownerReference metav1.OwnerReference) error {
+	start := time.Now()
+	err := o.client.CreateSecret(objectMeta, data, ownerReference)
+	o.observe("CreateSecret", start, err)
+	return err
+}
+
+func (o *instrumentedClient) CreateTypedSecret(objectMeta metav1.ObjectMeta, secretType corev1.SecretType, data map[string][]byte, ownerReference metav1.OwnerReference) (*corev1.Secret, error) {
+	start := time.Now()
+	result, err := o.client.CreateTypedSecret(objectMeta, secretType, data, ownerReference)
+	o.observe("CreateTypedSecret", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) UpdateSecretData(name string, data map[string][]byte) (*corev1.Secret, error) {
+	start := time.Now()
+	result, err := o.client.UpdateSecretData(name, data)
+	o.observe("UpdateSecretData", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) CreateSecrets(componentName string, commonObjectMeta metav1.ObjectMeta, svc *corev1.Service, ownerReference metav1.OwnerReference) error {
+	start := time.Now()
+	err := o.client.CreateSecrets(componentName, commonObjectMeta, svc, ownerReference)
+	o.observe("CreateSecrets", start, err)
+	return err
+}
+
+func (o *instrumentedClient) ListSecrets(labelSelector string) ([]corev1.Secret, error) {
+	start := time.Now()
+	result, err := o.client.ListSecrets(labelSelector)
+	o.observe("ListSecrets", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetSecretsByLabel(labels map[string]string) ([]corev1.Secret, error) {
+	start := time.Now()
+	result, err := o.client.GetSecretsByLabel(labels)
+	o.observe("GetSecretsByLabel", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) WaitAndGetSecret(name string, namespace string) (*corev1.Secret, error) {
+	start := time.Now()
+	result, err := o.client.WaitAndGetSecret(name, namespace)
+	o.observe("WaitAndGetSecret", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) CreateService(svc corev1.Service) (*corev1.Service, error) {
+	start := time.Now()
+	result, err := o.client.CreateService(svc)
+	o.observe("CreateService", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) UpdateService(svc corev1.Service) (*corev1.Service, error) {
+	start := time.Now()
+	result, err := o.client.UpdateService(svc)
+	o.observe("UpdateService", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) ListServices(selector string) ([]corev1.Service, error) {
+	start := time.Now()
+	result, err := o.client.ListServices(selector)
+	o.observe("ListServices", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) DeleteService(serviceName string) error {
+	start := time.Now()
+	err := o.client.DeleteService(serviceName)
+	o.observe("DeleteService", start, err)
+	return err
+}
+
+func (o *instrumentedClient) GetOneService(componentName, appName string, isPartOfComponent bool) (*corev1.Service, error) {
+	start := time.Now()
+	result, err := o.client.GetOneService(componentName, appName, isPartOfComponent)
+	o.observe("GetOneService", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetOneServiceFromSelector(selector string) (*corev1.Service, error) {
+	start := time.Now()
+	result, err := o.client.GetOneServiceFromSelector(selector)
+	o.observe("GetOneServiceFromSelector", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetLinkTarget(componentName, appName, mode string) (*LinkTarget, error) {
+	start := time.Now()
+	result, err := o.client.GetLinkTarget(componentName, appName, mode)
+	o.observe("GetLinkTarget", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) RunLogout(stdout io.Writer) error {
+	start := time.Now()
+	err := o.client.RunLogout(stdout)
+	o.observe("RunLogout", start, err)
+	return err
+}
+
+func (o *instrumentedClient) CreatePVC(pvc corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+	start := time.Now()
+	result, err := o.client.CreatePVC(pvc)
+	o.observe("CreatePVC", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) DeletePVC(pvcName string) error {
+	start := time.Now()
+	err := o.client.DeletePVC(pvcName)
+	o.observe("DeletePVC", start, err)
+	return err
+}
+
+func (o *instrumentedClient) ListPVCs(selector string) ([]corev1.PersistentVolumeClaim, error) {
+	start := time.Now()
+	result, err := o.client.ListPVCs(selector)
+	o.observe("ListPVCs", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) ListPVCNames(selector string) ([]string, error) {
+	start := time.Now()
+	result, err := o.client.ListPVCNames(selector)
+	o.observe("ListPVCNames", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetPVCFromName(pvcName string) (*corev1.PersistentVolumeClaim, error) {
+	start := time.Now()
+	result, err := o.client.GetPVCFromName(pvcName)
+	o.observe("GetPVCFromName", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) UpdatePVCLabels(pvc *corev1.PersistentVolumeClaim, labels map[string]string) error {
+	start := time.Now()
+	err := o.client.UpdatePVCLabels(pvc, labels)
+	o.observe("UpdatePVCLabels", start, err)
+	return err
+}
+
+func (o *instrumentedClient) ForceDeleteStuckResources(selector string, olderThan time.Duration) (StuckResourcesReport, error) {
+	start := time.Now()
+	result, err := o.client.ForceDeleteStuckResources(selector, olderThan)
+	o.observe("ForceDeleteStuckResources", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) UpdateStorageOwnerReference(pvc *corev1.PersistentVolumeClaim, ownerReference ...metav1.OwnerReference) error {
+	start := time.Now()
+	err := o.client.UpdateStorageOwnerReference(pvc, ownerReference...)
+	o.observe("UpdateStorageOwnerReference", start, err)
+	return err
+}
+
+func (o *instrumentedClient) ListIngresses(namespace, selector string) (*v1.IngressList, error) {
+	start := time.Now()
+	result, err := o.client.ListIngresses(namespace, selector)
+	o.observe("ListIngresses", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) ListJobs(selector string) (*batchv1.JobList, error) {
+	start := time.Now()
+	result, err := o.client.ListJobs(selector)
+	o.observe("ListJobs", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) CreateJob(job batchv1.Job, namespace string) (*batchv1.Job, error) {
+	start := time.Now()
+	result, err := o.client.CreateJob(job, namespace)
+	o.observe("CreateJob", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) WaitForJobToComplete(job *batchv1.Job) (*batchv1.Job, error) {
+	start := time.Now()
+	result, err := o.client.WaitForJobToComplete(job)
+	o.observe("WaitForJobToComplete", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) GetJobLogs(job *batchv1.Job, containerName string) (io.ReadCloser, error) {
+	start := time.Now()
+	result, err := o.client.GetJobLogs(job, containerName)
+	o.observe("GetJobLogs", start, err)
+	return result, err
+}
+
+func (o *instrumentedClient) DeleteJob(jobName string) error {
+	start := time.Now()
+	err := o.client.DeleteJob(jobName)
+	o.observe("DeleteJob", start, err)
+	return err
+}
+
+func (o *instrumentedClient) GetRegistryList() ([]api.Registry, error) {
+	start := time.Now()
+	result, err := o.client.GetRegistryList()
+	o.observe("GetRegistryList", start, err)
+	return result, err
+}
@@ -0,0 +1,90 @@
+package kclient
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktesting "k8s.io/client-go/testing"
+)
+
+type observation struct {
+	name   string
+	failed bool
+}
+
+type fakeMetricsRecorder struct {
+	mu           sync.Mutex
+	observations []observation
+}
+
+func (o *fakeMetricsRecorder) ObserveOperation(name string, _ time.Duration, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.observations = append(o.observations, observation{name: name, failed: err != nil})
+}
+
+func TestNewInstrumentedClient(t *testing.T) {
+	fakeClient, fakeClientSet := FakeNew()
+	fakeClient.Namespace = "ns"
+	fakeClient.Timeouts = Timeouts{Retry: 5 * time.Second}
+
+	getCalls := 0
+	fakeClientSet.Kubernetes.PrependReactor("get", "secrets", func(action ktesting.Action) (bool, runtime.Object, error) {
+		name := action.(ktesting.GetAction).GetName()
+		if name != "flaky" {
+			return true, nil, kerrors.NewNotFound(secretsResource, name)
+		}
+		// the first attempts fail with a transient error
+		getCalls++
+		if getCalls <= 2 {
+			return true, nil, kerrors.NewServiceUnavailable("etcd leader election")
+		}
+		return true, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}, nil
+	})
+
+	recorder := &fakeMetricsRecorder{}
+	client := NewInstrumentedClient(fakeClient, recorder)
+
+	if _, err := client.GetSecret("unknown", "ns"); err == nil {
+		t.Fatalf("expected an error when getting an unknown secret")
+	}
+	if _, err := client.ListPVCs(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the attempts of an operation retried on transient errors are observed once
+	if _, err := client.GetSecret("flaky", "ns"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if getCalls != 3 {
+		t.Errorf("expected the operation to be retried twice, got %d calls", getCalls)
+	}
+	objectMeta := metav1.ObjectMeta{Name: "port-secret", Labels: map[string]string{"component": "my-component"}}
+	if _, err := client.CreateTypedSecret(objectMeta, corev1.SecretTypeOpaque, map[string][]byte{}, metav1.OwnerReference{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.SetNamespace("other-ns")
+
+	want := []observation{
+		{name: "GetSecret", failed: true},
+		{name: "ListPVCs"},
+		{name: "GetSecret"},
+		{name: "CreateTypedSecret"},
+		{name: "SetNamespace"},
+	}
+	if diff := cmp.Diff(want, recorder.observations, cmp.AllowUnexported(observation{})); diff != "" {
+		t.Errorf("observations mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewInstrumentedClient_noRecorder(t *testing.T) {
+	fakeClient, _ := FakeNew()
+	if client := NewInstrumentedClient(fakeClient, nil); client != fakeClient {
+		t.Errorf("expected the client to be returned as is without recorder")
+	}
+}
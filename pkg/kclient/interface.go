@@ -91,6 +91,9 @@ type ClientInterface interface {
 	WaitForServiceAccountInNamespace(namespace, serviceAccountName string) error
 	GetCurrentNamespacePolicy() (psaApi.Policy, error)
 
+	// oauth.go
+	TokenValidUntil() (time.Time, error)
+
 	// oc_server.go
 	GetServerVersion(timeout time.Duration) (*ServerInfo, error)
 	GetOCVersion() (string, error)
@@ -111,7 +114,7 @@ type ClientInterface interface {
 	TryWithBlockOwnerDeletion(ownerReference metav1.OwnerReference, exec func(ownerReference metav1.OwnerReference) error) error
 
 	// pods.go
-	GetPodUsingComponentName(componentName string) (*corev1.Pod, error)
+	GetPodUsingComponentName(componentName string, appName string) (*corev1.Pod, error)
 	PodWatcher(ctx context.Context, selector string) (watch.Interface, error)
 	IsPodNameMatchingSelector(ctx context.Context, podname string, selector string) (bool, error)
 
@@ -135,8 +138,11 @@ type ClientInterface interface {
 	UpdateSecret(secret *corev1.Secret, namespace string) (*corev1.Secret, error)
 	DeleteSecret(secretName, namespace string) error
 	CreateSecret(objectMeta metav1.ObjectMeta, data map[string]string, ownerReference metav1.OwnerReference) error
+	CreateTypedSecret(objectMeta metav1.ObjectMeta, secretType corev1.SecretType, data map[string][]byte, ownerReference metav1.OwnerReference) (*corev1.Secret, error)
+	UpdateSecretData(name string, data map[string][]byte) (*corev1.Secret, error)
 	CreateSecrets(componentName string, commonObjectMeta metav1.ObjectMeta, svc *corev1.Service, ownerReference metav1.OwnerReference) error
 	ListSecrets(labelSelector string) ([]corev1.Secret, error)
+	GetSecretsByLabel(labels map[string]string) ([]corev1.Secret, error)
 	WaitAndGetSecret(name string, namespace string) (*corev1.Secret, error)
 
 	// service.go
@@ -146,6 +152,7 @@ type ClientInterface interface {
 	DeleteService(serviceName string) error
 	GetOneService(componentName, appName string, isPartOfComponent bool) (*corev1.Service, error)
 	GetOneServiceFromSelector(selector string) (*corev1.Service, error)
+	GetLinkTarget(componentName, appName, mode string) (*LinkTarget, error)
 
 	// user.go
 	RunLogout(stdout io.Writer) error
@@ -157,6 +164,7 @@ type ClientInterface interface {
 	ListPVCNames(selector string) ([]string, error)
 	GetPVCFromName(pvcName string) (*corev1.PersistentVolumeClaim, error)
 	UpdatePVCLabels(pvc *corev1.PersistentVolumeClaim, labels map[string]string) error
+	ForceDeleteStuckResources(selector string, olderThan time.Duration) (StuckResourcesReport, error)
 	UpdateStorageOwnerReference(pvc *corev1.PersistentVolumeClaim, ownerReference ...metav1.OwnerReference) error
 
 	// ingress_routes.go
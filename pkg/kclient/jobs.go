@@ -24,7 +24,12 @@ const (
 )
 
 func (c *Client) ListJobs(selector string) (*batchv1.JobList, error) {
-	return c.KubeClient.BatchV1().Jobs(c.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	var jobs *batchv1.JobList
+	err := c.retryTransient(func() (err error) {
+		jobs, err = c.KubeClient.BatchV1().Jobs(c.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+		return err
+	})
+	return jobs, err
 }
 
 // CreateJobs creates a K8s job to execute task
@@ -2,9 +2,9 @@ package kclient
 
 import (
 	"errors"
-	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/log"
 	"k8s.io/kubectl/pkg/util/term"
@@ -30,6 +30,7 @@ import (
 	// api clientsets
 	servicecatalogclienset "github.com/kubernetes-sigs/service-catalog/pkg/client/clientset_generated/clientset/typed/servicecatalog/v1beta1"
 	configclientset "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
+	oauthclientset "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
 	projectclientset "github.com/openshift/client-go/project/clientset/versioned/typed/project/v1"
 	routeclientset "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
 	userclientset "github.com/openshift/client-go/user/clientset/versioned/typed/user/v1"
@@ -40,12 +41,6 @@ import (
 )
 
 const (
-	// errorMsg is the message for user when invalid configuration error occurs
-	errorMsg = `
-Please ensure you have an active kubernetes context to your cluster. 
-Consult your Kubernetes distribution's documentation for more details.
-Error: %w
-`
 	defaultQPS   = 200
 	defaultBurst = 200
 )
@@ -56,6 +51,7 @@ type Client struct {
 	KubeConfig           clientcmd.ClientConfig
 	KubeClientConfig     *rest.Config
 	Namespace            string
+	Timeouts             Timeouts
 	OperatorClient       *operatorsclientset.OperatorsV1alpha1Client
 	appsClient           appsclientset.AppsV1Interface
 	serviceCatalogClient servicecatalogclienset.ServicecatalogV1beta1Interface
@@ -82,6 +78,7 @@ type Client struct {
 	projectClient projectclientset.ProjectV1Interface
 	routeClient   routeclientset.RouteV1Interface
 	configClient  *configclientset.ConfigV1Client
+	oauthClient   oauthclientset.OauthV1Interface
 }
 
 var _ ClientInterface = (*Client)(nil)
@@ -125,8 +122,11 @@ func NewForConfig(config clientcmd.ClientConfig) (client *Client, err error) {
 	client.KubeConfig = config
 
 	client.KubeClientConfig, err = client.KubeConfig.ClientConfig()
+	if err == nil {
+		err = checkClientCertificate(client.KubeClientConfig, time.Now())
+	}
 	if err != nil {
-		return nil, fmt.Errorf(errorMsg, err)
+		return nil, newInvalidKubeconfigError(client.KubeConfig, err)
 	}
 
 	// For the rest CLIENT, we set the QPS and Burst to high values so
@@ -199,6 +199,11 @@ func NewForConfig(config clientcmd.ClientConfig) (client *Client, err error) {
 		return nil, err
 	}
 
+	client.oauthClient, err = oauthclientset.NewForConfig(client.KubeClientConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	client.projectClient, err = projectclientset.NewForConfig(client.KubeClientConfig)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,126 @@
+package kclient
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/log"
+)
+
+// modifyKubeConfig applies modify to the kubeconfig, as found by configAccess, and saves it.
+//
+// The kubeconfig is read again just before being modified, instead of using the configuration
+// loaded when the client was created, so that the entries added or changed by another process in the meantime
+// are not reverted. Only the entries changed by modify are written, in the file defining them.
+//
+// clientcmd.ModifyConfig only locks each file while writing it, not during the whole read-modify-write:
+// the lock cannot be taken here, as ModifyConfig would then fail to acquire it. Re-reading the kubeconfig
+// only narrows the window, and a change of the same entries by another process between the read
+// and the write can still be lost.
+//
+// If the kubeconfig is read-only, the modification is skipped with a warning.
+func modifyKubeConfig(configAccess clientcmd.ConfigAccess, modify func(config *clientcmdapi.Config) error) error {
+	config, err := configAccess.GetStartingConfig()
+	if err != nil {
+		return err
+	}
+
+	err = modify(config)
+	if err != nil {
+		return err
+	}
+
+	err = clientcmd.ModifyConfig(configAccess, *config, true)
+	if errors.Is(err, fs.ErrPermission) || errors.Is(err, syscall.EROFS) {
+		klog.V(4).Infof("unable to write kubeconfig: %v", err)
+		log.Warning("The kubeconfig file is read-only and has not been modified")
+		return nil
+	}
+	return err
+}
+
+// newInvalidKubeconfigError classifies the error returned by clientcmd when loading the client configuration
+func newInvalidKubeconfigError(config clientcmd.ClientConfig, err error) *InvalidKubeconfigError {
+	return &InvalidKubeconfigError{
+		KubeconfigReason: classifyKubeconfigError(err),
+		Server:           currentServer(config),
+		Err:              err,
+	}
+}
+
+func classifyKubeconfigError(err error) KubeconfigErrorReason {
+	var certErr x509.CertificateInvalidError
+	switch {
+	case clientcmd.IsEmptyConfig(err):
+		return KubeconfigMissing
+	case strings.Contains(err.Error(), "error loading config file"):
+		// clientcmd does not wrap the parsing errors of the kubeconfig files
+		return KubeconfigMalformed
+	case clientcmd.IsContextNotFound(err):
+		return KubeconfigContextNotFound
+	case errors.As(err, &certErr) && certErr.Reason == x509.Expired:
+		return KubeconfigCertificateExpired
+	default:
+		return KubeconfigInvalid
+	}
+}
+
+// currentServer returns the server of the current context of the kubeconfig, or an empty string if it cannot be determined
+func currentServer(config clientcmd.ClientConfig) string {
+	raw, err := config.RawConfig()
+	if err != nil {
+		return ""
+	}
+	kubeContext, ok := raw.Contexts[raw.CurrentContext]
+	if !ok {
+		return ""
+	}
+	cluster, ok := raw.Clusters[kubeContext.Cluster]
+	if !ok {
+		return ""
+	}
+	return cluster.Server
+}
+
+// checkClientCertificate returns an error if the client certificate defined in restConfig has expired at the given time.
+// Certificates which cannot be read or parsed are not reported, the error being returned when connecting to the cluster
+func checkClientCertificate(restConfig *rest.Config, now time.Time) error {
+	data := restConfig.CertData
+	if len(data) == 0 && restConfig.CertFile != "" {
+		var err error
+		data, err = os.ReadFile(restConfig.CertFile)
+		if err != nil {
+			klog.V(4).Infof("unable to read the client certificate %q: %v", restConfig.CertFile, err)
+			return nil
+		}
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		klog.V(4).Infof("unable to parse the client certificate: %v", err)
+		return nil
+	}
+	if now.After(cert.NotAfter) {
+		return x509.CertificateInvalidError{
+			Cert:   cert,
+			Reason: x509.Expired,
+			Detail: fmt.Sprintf("the client certificate has expired on %s", cert.NotAfter.Format(time.RFC3339)),
+		}
+	}
+	return nil
+}
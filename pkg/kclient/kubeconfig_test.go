@@ -0,0 +1,303 @@
+package kclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// kubeconfigMain defines the current context
+	kubeconfigMain = `apiVersion: v1
+kind: Config
+current-context: dev
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+    namespace: ns1
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://dev.example.com:6443
+users:
+- name: dev-user
+  user:
+    token: dev-token
+`
+	// kubeconfigOther defines another context, in another file
+	kubeconfigOther = `apiVersion: v1
+kind: Config
+contexts:
+- name: prod
+  context:
+    cluster: prod-cluster
+    user: prod-user
+    namespace: prod-ns
+clusters:
+- name: prod-cluster
+  cluster:
+    server: https://prod.example.com:6443
+users:
+- name: prod-user
+  user:
+    token: prod-token
+`
+)
+
+// setupKubeconfig writes the main and other kubeconfig files in a temporary directory,
+// and sets KUBECONFIG to both files
+func setupKubeconfig(t *testing.T) (mainFile, otherFile string) {
+	dir := t.TempDir()
+	mainFile = filepath.Join(dir, "main")
+	otherFile = filepath.Join(dir, "other")
+	for file, content := range map[string]string{mainFile: kubeconfigMain, otherFile: kubeconfigOther} {
+		if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("KUBECONFIG", mainFile+string(os.PathListSeparator)+otherFile)
+	return mainFile, otherFile
+}
+
+func loadKubeconfigFile(t *testing.T, file string) *clientcmdapi.Config {
+	config, err := clientcmd.LoadFromFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
+
+func TestSetCurrentNamespace_multipleFiles(t *testing.T) {
+	mainFile, otherFile := setupKubeconfig(t)
+	otherBefore, err := os.ReadFile(otherFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &Client{}
+	err = client.SetCurrentNamespace("ns2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := loadKubeconfigFile(t, mainFile).Contexts["dev"].Namespace; got != "ns2" {
+		t.Errorf("expected namespace of current context to be %q, got %q", "ns2", got)
+	}
+	otherAfter, err := os.ReadFile(otherFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(otherBefore) != string(otherAfter) {
+		t.Errorf("file not defining the current context should not be modified, got:\n%s", otherAfter)
+	}
+	if client.GetCurrentNamespace() != "ns2" {
+		t.Errorf("expected namespace of client to be %q, got %q", "ns2", client.GetCurrentNamespace())
+	}
+}
+
+func TestSetCurrentNamespace_concurrentModification(t *testing.T) {
+	mainFile, _ := setupKubeconfig(t)
+
+	// the kubeconfig is loaded when the client is created
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	client := &Client{
+		KubeConfig: clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}),
+	}
+	if _, err := client.KubeConfig.RawConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	// another process adds a context after the client has been created
+	config := loadKubeconfigFile(t, mainFile)
+	config.Contexts["added"] = &clientcmdapi.Context{Cluster: "dev-cluster", AuthInfo: "dev-user"}
+	if err := clientcmd.WriteToFile(*config, mainFile); err != nil {
+		t.Fatal(err)
+	}
+
+	err := client.SetCurrentNamespace("ns2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config = loadKubeconfigFile(t, mainFile)
+	if _, ok := config.Contexts["added"]; !ok {
+		t.Errorf("context added by another process should be kept")
+	}
+	if got := config.Contexts["dev"].Namespace; got != "ns2" {
+		t.Errorf("expected namespace of current context to be %q, got %q", "ns2", got)
+	}
+}
+
+func TestSetCurrentNamespace_readOnly(t *testing.T) {
+	if runtime.GOOS == "windows" || os.Geteuid() == 0 {
+		t.Skip("file permissions cannot make the kubeconfig read-only")
+	}
+	mainFile, _ := setupKubeconfig(t)
+	dir := filepath.Dir(mainFile)
+	if err := os.Chmod(mainFile, 0400); err != nil {
+		t.Fatal(err)
+	}
+	// prevent the creation of the lock file
+	if err := os.Chmod(dir, 0500); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chmod(dir, 0700)
+	}()
+
+	client := &Client{}
+	err := client.SetCurrentNamespace("ns2")
+	if err != nil {
+		t.Fatalf("no error expected for a read-only kubeconfig, got %v", err)
+	}
+	if got := loadKubeconfigFile(t, mainFile).Contexts["dev"].Namespace; got != "ns1" {
+		t.Errorf("expected read-only kubeconfig to be unchanged, got namespace %q", got)
+	}
+}
+
+func Test_modifyKubeConfig_removeToken(t *testing.T) {
+	mainFile, otherFile := setupKubeconfig(t)
+
+	err := modifyKubeConfig(clientcmd.NewDefaultClientConfigLoadingRules(), func(config *clientcmdapi.Config) error {
+		config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo].Token = ""
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := loadKubeconfigFile(t, mainFile).AuthInfos["dev-user"].Token; got != "" {
+		t.Errorf("expected token of current user to be removed, got %q", got)
+	}
+	if got := loadKubeconfigFile(t, otherFile).AuthInfos["prod-user"].Token; got != "prod-token" {
+		t.Errorf("expected token of other user to be kept, got %q", got)
+	}
+}
+
+// generateCertificate returns a PEM encoded self-signed certificate and its key, valid until notAfter
+func generateCertificate(t *testing.T, notAfter time.Time) (cert, key []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "developer"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func configWithCertificate(t *testing.T, notAfter time.Time) clientcmd.ClientConfig {
+	cert, key := generateCertificate(t, notAfter)
+	config := clientcmdapi.NewConfig()
+	config.Clusters["dev-cluster"] = &clientcmdapi.Cluster{Server: "https://dev.example.com:6443"}
+	config.AuthInfos["dev-user"] = &clientcmdapi.AuthInfo{ClientCertificateData: cert, ClientKeyData: key}
+	config.Contexts["dev"] = &clientcmdapi.Context{Cluster: "dev-cluster", AuthInfo: "dev-user"}
+	config.CurrentContext = "dev"
+	return clientcmd.NewDefaultClientConfig(*config, nil)
+}
+
+func TestNewForConfig_invalidKubeconfig(t *testing.T) {
+	malformedFile := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(malformedFile, []byte("clusters: [this is not yaml"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	missingContext := loadKubeconfigFromString(t, kubeconfigMain)
+	missingContext.CurrentContext = "deleted"
+
+	tests := []struct {
+		name       string
+		config     clientcmd.ClientConfig
+		wantReason KubeconfigErrorReason
+		wantServer string
+		wantHint   string
+	}{
+		{
+			name:       "no kubeconfig",
+			config:     clientcmd.NewDefaultClientConfig(*clientcmdapi.NewConfig(), nil),
+			wantReason: KubeconfigMissing,
+			wantHint:   "odo login <cluster-url>",
+		},
+		{
+			name: "malformed kubeconfig file",
+			config: clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+				&clientcmd.ClientConfigLoadingRules{Precedence: []string{malformedFile}},
+				&clientcmd.ConfigOverrides{}),
+			wantReason: KubeconfigMalformed,
+			wantHint:   "Fix the syntax of the kubeconfig file",
+		},
+		{
+			name:       "current context not found",
+			config:     clientcmd.NewDefaultClientConfig(*missingContext, nil),
+			wantReason: KubeconfigContextNotFound,
+			wantHint:   "kubectl config use-context",
+		},
+		{
+			name:       "expired client certificate",
+			config:     configWithCertificate(t, time.Now().Add(-time.Hour)),
+			wantReason: KubeconfigCertificateExpired,
+			wantServer: "https://dev.example.com:6443",
+			wantHint:   "odo login https://dev.example.com:6443",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewForConfig(tt.config)
+			var kubeconfigErr *InvalidKubeconfigError
+			if !errors.As(err, &kubeconfigErr) {
+				t.Fatalf("expected an InvalidKubeconfigError, got %v", err)
+			}
+			if kubeconfigErr.KubeconfigReason != tt.wantReason {
+				t.Errorf("expected reason %q, got %q (error: %v)", tt.wantReason, kubeconfigErr.KubeconfigReason, err)
+			}
+			if kubeconfigErr.Server != tt.wantServer {
+				t.Errorf("expected server %q, got %q", tt.wantServer, kubeconfigErr.Server)
+			}
+			if !strings.Contains(err.Error(), tt.wantHint) {
+				t.Errorf("expected the error to contain %q, got %q", tt.wantHint, err.Error())
+			}
+		})
+	}
+}
+
+func TestNewForConfig_validCertificate(t *testing.T) {
+	_, err := NewForConfig(configWithCertificate(t, time.Now().Add(time.Hour)))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func loadKubeconfigFromString(t *testing.T, content string) *clientcmdapi.Config {
+	config, err := clientcmd.Load([]byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return config
+}
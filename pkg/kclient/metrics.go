@@ -0,0 +1,17 @@
+package kclient
+
+import (
+	"time"
+)
+
+// MetricsRecorder observes the operations done by a client on the cluster, see NewInstrumentedClient.
+//
+// The name of an operation is the name of the method of ClientInterface called
+// (e.g. "GetSecret", "CreateDeployment", "ListPVCs").
+// These names are stable, and can be used to define alerts or dashboards.
+type MetricsRecorder interface {
+	// ObserveOperation is called once per call to a method of the client, with the duration of the call.
+	// The operations retried on transient errors are observed once, with the duration of all the attempts.
+	// err is the error returned by the method, or nil if the method does not return an error
+	ObserveOperation(name string, duration time.Duration, err error)
+}
@@ -0,0 +1,40 @@
+// Package prometheus provides a kclient.MetricsRecorder exposing the durations of the operations
+// done on the cluster as Prometheus metrics
+package prometheus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsRecorder is a kclient.MetricsRecorder exposing the duration of the operations
+// as the histogram odo_cluster_operation_duration_seconds, with the labels operation and result (success or error)
+type MetricsRecorder struct {
+	durations *prometheus.HistogramVec
+}
+
+// NewMetricsRecorder creates a MetricsRecorder, and registers its metrics with registerer.
+// An error is returned if the metrics are already registered
+func NewMetricsRecorder(registerer prometheus.Registerer) (*MetricsRecorder, error) {
+	durations := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "odo",
+		Name:      "cluster_operation_duration_seconds",
+		Help:      "Duration of the operations done by odo on the cluster",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "result"})
+	err := registerer.Register(durations)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register metrics: %w", err)
+	}
+	return &MetricsRecorder{durations: durations}, nil
+}
+
+func (o *MetricsRecorder) ObserveOperation(name string, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	o.durations.WithLabelValues(name, result).Observe(duration.Seconds())
+}
@@ -0,0 +1,45 @@
+package prometheus
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestNewMetricsRecorder(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	recorder, err := NewMetricsRecorder(registry)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	recorder.ObserveOperation("GetSecret", time.Second, nil)
+	recorder.ObserveOperation("GetSecret", time.Second, errors.New("not found"))
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(families) != 1 || families[0].GetName() != "odo_cluster_operation_duration_seconds" {
+		t.Fatalf("expected the odo_cluster_operation_duration_seconds metric, got %v", families)
+	}
+	results := map[string]uint64{}
+	for _, metric := range families[0].GetMetric() {
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == "result" {
+				results[label.GetValue()] = metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	if diff := cmp.Diff(map[string]uint64{"success": 1, "error": 1}, results); diff != "" {
+		t.Errorf("results mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = NewMetricsRecorder(registry)
+	if err == nil {
+		t.Errorf("expected an error when the metrics are already registered")
+	}
+}
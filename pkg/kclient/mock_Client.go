@@ -204,6 +204,21 @@ func (mr *MockClientInterfaceMockRecorder) CreateTLSSecret(tlsCertificate, tlsPr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTLSSecret", reflect.TypeOf((*MockClientInterface)(nil).CreateTLSSecret), tlsCertificate, tlsPrivKey, objectMeta)
 }
 
+// CreateTypedSecret mocks base method.
+func (m *MockClientInterface) CreateTypedSecret(objectMeta v14.ObjectMeta, secretType v12.SecretType, data map[string][]byte, ownerReference v14.OwnerReference) (*v12.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTypedSecret", objectMeta, secretType, data, ownerReference)
+	ret0, _ := ret[0].(*v12.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTypedSecret indicates an expected call of CreateTypedSecret.
+func (mr *MockClientInterfaceMockRecorder) CreateTypedSecret(objectMeta, secretType, data, ownerReference interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTypedSecret", reflect.TypeOf((*MockClientInterface)(nil).CreateTypedSecret), objectMeta, secretType, data, ownerReference)
+}
+
 // DeleteDynamicResource mocks base method.
 func (m *MockClientInterface) DeleteDynamicResource(name string, gvr schema.GroupVersionResource, wait bool) error {
 	m.ctrl.T.Helper()
@@ -331,6 +346,21 @@ func (mr *MockClientInterfaceMockRecorder) ExecCMDInContainer(ctx, containerName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecCMDInContainer", reflect.TypeOf((*MockClientInterface)(nil).ExecCMDInContainer), ctx, containerName, podName, cmd, stdout, stderr, stdin, tty)
 }
 
+// ForceDeleteStuckResources mocks base method.
+func (m *MockClientInterface) ForceDeleteStuckResources(selector string, olderThan time.Duration) (StuckResourcesReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceDeleteStuckResources", selector, olderThan)
+	ret0, _ := ret[0].(StuckResourcesReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceDeleteStuckResources indicates an expected call of ForceDeleteStuckResources.
+func (mr *MockClientInterfaceMockRecorder) ForceDeleteStuckResources(selector, olderThan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceDeleteStuckResources", reflect.TypeOf((*MockClientInterface)(nil).ForceDeleteStuckResources), selector, olderThan)
+}
+
 // GeneratePortForwardReq mocks base method.
 func (m *MockClientInterface) GeneratePortForwardReq(podName string) *rest.Request {
 	m.ctrl.T.Helper()
@@ -653,6 +683,21 @@ func (mr *MockClientInterfaceMockRecorder) GetJobLogs(job, containerName interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobLogs", reflect.TypeOf((*MockClientInterface)(nil).GetJobLogs), job, containerName)
 }
 
+// GetLinkTarget mocks base method.
+func (m *MockClientInterface) GetLinkTarget(componentName, appName, mode string) (*LinkTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLinkTarget", componentName, appName, mode)
+	ret0, _ := ret[0].(*LinkTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLinkTarget indicates an expected call of GetLinkTarget.
+func (mr *MockClientInterfaceMockRecorder) GetLinkTarget(componentName, appName, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLinkTarget", reflect.TypeOf((*MockClientInterface)(nil).GetLinkTarget), componentName, appName, mode)
+}
+
 // GetNamespace mocks base method.
 func (m *MockClientInterface) GetNamespace(name string) (*v12.Namespace, error) {
 	m.ctrl.T.Helper()
@@ -819,18 +864,18 @@ func (mr *MockClientInterfaceMockRecorder) GetPodLogs(podName, containerName, fo
 }
 
 // GetPodUsingComponentName mocks base method.
-func (m *MockClientInterface) GetPodUsingComponentName(componentName string) (*v12.Pod, error) {
+func (m *MockClientInterface) GetPodUsingComponentName(componentName, appName string) (*v12.Pod, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPodUsingComponentName", componentName)
+	ret := m.ctrl.Call(m, "GetPodUsingComponentName", componentName, appName)
 	ret0, _ := ret[0].(*v12.Pod)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPodUsingComponentName indicates an expected call of GetPodUsingComponentName.
-func (mr *MockClientInterfaceMockRecorder) GetPodUsingComponentName(componentName interface{}) *gomock.Call {
+func (mr *MockClientInterfaceMockRecorder) GetPodUsingComponentName(componentName, appName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodUsingComponentName", reflect.TypeOf((*MockClientInterface)(nil).GetPodUsingComponentName), componentName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodUsingComponentName", reflect.TypeOf((*MockClientInterface)(nil).GetPodUsingComponentName), componentName, appName)
 }
 
 // GetPodsMatchingSelector mocks base method.
@@ -953,6 +998,21 @@ func (mr *MockClientInterfaceMockRecorder) GetSecret(name, namespace interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockClientInterface)(nil).GetSecret), name, namespace)
 }
 
+// GetSecretsByLabel mocks base method.
+func (m *MockClientInterface) GetSecretsByLabel(labels map[string]string) ([]v12.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretsByLabel", labels)
+	ret0, _ := ret[0].([]v12.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretsByLabel indicates an expected call of GetSecretsByLabel.
+func (mr *MockClientInterfaceMockRecorder) GetSecretsByLabel(labels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretsByLabel", reflect.TypeOf((*MockClientInterface)(nil).GetSecretsByLabel), labels)
+}
+
 // GetServerVersion mocks base method.
 func (m *MockClientInterface) GetServerVersion(timeout time.Duration) (*ServerInfo, error) {
 	m.ctrl.T.Helper()
@@ -1411,6 +1471,21 @@ func (mr *MockClientInterfaceMockRecorder) SetupPortForwarding(pod, portPairs, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupPortForwarding", reflect.TypeOf((*MockClientInterface)(nil).SetupPortForwarding), pod, portPairs, out, errOut, stopChan, address)
 }
 
+// TokenValidUntil mocks base method.
+func (m *MockClientInterface) TokenValidUntil() (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenValidUntil")
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TokenValidUntil indicates an expected call of TokenValidUntil.
+func (mr *MockClientInterfaceMockRecorder) TokenValidUntil() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenValidUntil", reflect.TypeOf((*MockClientInterface)(nil).TokenValidUntil))
+}
+
 // TryWithBlockOwnerDeletion mocks base method.
 func (m *MockClientInterface) TryWithBlockOwnerDeletion(ownerReference v14.OwnerReference, exec func(v14.OwnerReference) error) error {
 	m.ctrl.T.Helper()
@@ -1483,6 +1558,21 @@ func (mr *MockClientInterfaceMockRecorder) UpdateSecret(secret, namespace interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecret", reflect.TypeOf((*MockClientInterface)(nil).UpdateSecret), secret, namespace)
 }
 
+// UpdateSecretData mocks base method.
+func (m *MockClientInterface) UpdateSecretData(name string, data map[string][]byte) (*v12.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecretData", name, data)
+	ret0, _ := ret[0].(*v12.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecretData indicates an expected call of UpdateSecretData.
+func (mr *MockClientInterfaceMockRecorder) UpdateSecretData(name, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecretData", reflect.TypeOf((*MockClientInterface)(nil).UpdateSecretData), name, data)
+}
+
 // UpdateService mocks base method.
 func (m *MockClientInterface) UpdateService(svc v12.Service) (*v12.Service, error) {
 	m.ctrl.T.Helper()
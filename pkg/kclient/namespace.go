@@ -12,22 +12,19 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog"
 	"k8s.io/pod-security-admission/api"
 	psaApi "k8s.io/pod-security-admission/api"
 )
 
-const (
-	// timeout for waiting for namespace deletion
-	waitForNamespaceDeletionTimeOut = 3 * time.Minute
-
-	// timeout for getting the default service account
-	getDefaultServiceAccTimeout = 1 * time.Minute
-)
-
 // GetNamespaces return list of existing namespaces that user has access to.
 func (c *Client) GetNamespaces() ([]string, error) {
-	namespaces, err := c.KubeClient.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
+	var namespaces *corev1.NamespaceList
+	err := c.retryTransient(func() (err error) {
+		namespaces, err = c.KubeClient.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to list namespaces: %w", err)
 	}
@@ -42,7 +39,7 @@ func (c *Client) GetNamespaces() ([]string, error) {
 // GetNamespace returns Namespace based on its name
 // Errors related to project not being found or forbidden are translated to nil project for compatibility
 func (c *Client) GetNamespace(name string) (*corev1.Namespace, error) {
-	ns, err := c.KubeClient.CoreV1().Namespaces().Get(context.TODO(), name, metav1.GetOptions{})
+	ns, err := c.GetNamespaceNormal(name)
 	if err != nil {
 		istatus, ok := err.(kerrors.APIStatus)
 		if ok {
@@ -61,11 +58,20 @@ func (c *Client) GetNamespace(name string) (*corev1.Namespace, error) {
 
 // GetNamespace returns Namespace based on its name
 func (c *Client) GetNamespaceNormal(name string) (*corev1.Namespace, error) {
-	return c.KubeClient.CoreV1().Namespaces().Get(context.TODO(), name, metav1.GetOptions{})
+	var ns *corev1.Namespace
+	err := c.retryTransient(func() (err error) {
+		ns, err = c.KubeClient.CoreV1().Namespaces().Get(context.TODO(), name, metav1.GetOptions{})
+		return err
+	})
+	return ns, err
 }
 
 // CreateNamespace creates new namespace
 func (c *Client) CreateNamespace(name string) (*corev1.Namespace, error) {
+	if err := ValidateNamespaceName(name); err != nil {
+		return nil, err
+	}
+
 	namespace := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
@@ -136,24 +142,29 @@ func (c *Client) DeleteNamespace(name string, wait bool) error {
 			return nil
 		case err := <-watchErrorChannel:
 			return err
-		case <-time.After(waitForNamespaceDeletionTimeOut):
-			return fmt.Errorf("waited %s but couldn't delete namespace %s in time", waitForNamespaceDeletionTimeOut, name)
+		case <-time.After(c.timeouts().ResourceDeletion):
+			return fmt.Errorf("waited %s but couldn't delete namespace %s in time", c.timeouts().ResourceDeletion, name)
 		}
 
 	}
 	return nil
 }
 
-// SetCurrentNamespace change current namespace in kubeconfig
+// SetCurrentNamespace change current namespace in kubeconfig.
+// Only the format of the name is checked, as the reserved namespaces can be used once they exist
 func (c *Client) SetCurrentNamespace(namespace string) error {
-	rawConfig, err := c.KubeConfig.RawConfig()
-	if err != nil {
-		return fmt.Errorf("unable to switch to %s project: %w", namespace, err)
+	if err := ValidateNamespaceName(namespace); err != nil {
+		return err
 	}
 
-	rawConfig.Contexts[rawConfig.CurrentContext].Namespace = namespace
-
-	err = clientcmd.ModifyConfig(clientcmd.NewDefaultClientConfigLoadingRules(), rawConfig, true)
+	err := modifyKubeConfig(clientcmd.NewDefaultClientConfigLoadingRules(), func(config *clientcmdapi.Config) error {
+		currentContext, ok := config.Contexts[config.CurrentContext]
+		if !ok {
+			return fmt.Errorf("current context %q not found", config.CurrentContext)
+		}
+		currentContext.Namespace = namespace
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("unable to switch to %s project: %w", namespace, err)
 	}
@@ -180,7 +191,7 @@ func (c *Client) WaitForServiceAccountInNamespace(namespace, serviceAccountName
 		return err
 	}
 
-	timeout := time.After(getDefaultServiceAccTimeout)
+	timeout := time.After(c.timeouts().ServiceAccount)
 	if watcher != nil {
 		defer watcher.Stop()
 		for {
@@ -0,0 +1,41 @@
+package kclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// sha256TokenPrefix is the prefix of the OAuth access tokens granted by OpenShift
+const sha256TokenPrefix = "sha256~"
+
+// TokenValidUntil returns the expiration time of the OpenShift OAuth token used to access the cluster.
+// A zero time is returned if the token does not expire.
+// An error is returned if no OpenShift OAuth token is used (client certificates, tokens of service accounts, etc).
+func (c *Client) TokenValidUntil() (time.Time, error) {
+	token := c.KubeClientConfig.BearerToken
+	if token == "" {
+		return time.Time{}, errors.New("no token is used to access the cluster")
+	}
+	if !strings.HasPrefix(token, sha256TokenPrefix) {
+		return time.Time{}, errors.New("the token used to access the cluster is not an OpenShift OAuth token")
+	}
+
+	// the name of the token object is the hash of the token
+	hash := sha256.Sum256([]byte(strings.TrimPrefix(token, sha256TokenPrefix)))
+	name := sha256TokenPrefix + base64.RawURLEncoding.EncodeToString(hash[:])
+	accessToken, err := c.oauthClient.UserOAuthAccessTokens().Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to get the OAuth access token: %w", err)
+	}
+	if accessToken.ExpiresIn <= 0 {
+		return time.Time{}, nil
+	}
+	return accessToken.CreationTimestamp.Add(time.Duration(accessToken.ExpiresIn) * time.Second), nil
+}
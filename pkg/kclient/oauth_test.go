@@ -0,0 +1,119 @@
+package kclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	oauthclientset "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
+)
+
+func TestClient_unauthorizedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Unauthorized","code":401}`))
+	}))
+	defer server.Close()
+
+	client := &Client{Namespace: "ns", KubeClientConfig: &rest.Config{Host: server.URL}}
+	var err error
+	client.KubeClient, err = kubernetes.NewForConfig(client.KubeClientConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.ListPVCs("")
+	// the 401 response is returned untouched by the client, so that credential plugins can refresh the credentials
+	var statusErr *kerrors.StatusError
+	if !errors.As(err, &statusErr) || !kerrors.IsUnauthorized(err) {
+		t.Fatalf("expected an Unauthorized StatusError, got %v", err)
+	}
+
+	var notLoggedInErr *odoerrors.NotLoggedInError
+	if !errors.As(odoerrors.FromError(err), &notLoggedInErr) {
+		t.Errorf("expected the error to be classified as a NotLoggedInError, got %v", err)
+	}
+}
+
+// fakeOauthClient returns the UserOAuthAccessTokens defined in tokens
+type fakeOauthClient struct {
+	oauthclientset.OauthV1Interface
+	tokens map[string]*oauthv1.UserOAuthAccessToken
+}
+
+func (o fakeOauthClient) UserOAuthAccessTokens() oauthclientset.UserOAuthAccessTokenInterface {
+	return fakeUserOAuthAccessTokens{tokens: o.tokens}
+}
+
+type fakeUserOAuthAccessTokens struct {
+	oauthclientset.UserOAuthAccessTokenInterface
+	tokens map[string]*oauthv1.UserOAuthAccessToken
+}
+
+func (o fakeUserOAuthAccessTokens) Get(_ context.Context, name string, _ metav1.GetOptions) (*oauthv1.UserOAuthAccessToken, error) {
+	token, ok := o.tokens[name]
+	if !ok {
+		return nil, kerrors.NewNotFound(schema.GroupResource{Group: "oauth.openshift.io", Resource: "useroauthaccesstokens"}, name)
+	}
+	return token, nil
+}
+
+func TestClient_TokenValidUntil(t *testing.T) {
+	created := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	tokenName := func(token string) string {
+		hash := sha256.Sum256([]byte(strings.TrimPrefix(token, "sha256~")))
+		return "sha256~" + base64.RawURLEncoding.EncodeToString(hash[:])
+	}
+	tokens := map[string]*oauthv1.UserOAuthAccessToken{
+		tokenName("sha256~expiring"): {
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+			ExpiresIn:  86400,
+		},
+		tokenName("sha256~not-expiring"): {
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "expiring token", token: "sha256~expiring", want: created.Add(24 * time.Hour)},
+		{name: "token not expiring", token: "sha256~not-expiring"},
+		{name: "unknown token", token: "sha256~unknown", wantErr: true},
+		{name: "no token", token: "", wantErr: true},
+		{name: "not an OpenShift OAuth token", token: "eyJhbGciOiJSUzI1NiJ9", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{
+				KubeClientConfig: &rest.Config{BearerToken: tt.token},
+				oauthClient:      fakeOauthClient{tokens: tokens},
+			}
+			got, err := client.TokenValidUntil()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TokenValidUntil() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("TokenValidUntil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/remotecommand"
 
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/platform"
 )
 
@@ -65,17 +66,28 @@ func (c *Client) ExecCMDInContainer(ctx context.Context, containerName, podName
 	return nil
 }
 
-// GetPodUsingComponentName gets a pod using the component name
-func (c *Client) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
-	podSelector := fmt.Sprintf("component=%s", componentName)
-	return c.GetRunningPodFromSelector(podSelector)
+// GetPodUsingComponentName gets the running pod of the component in the application.
+// An error is returned if the labels of the pod do not identify the component and application
+func (c *Client) GetPodUsingComponentName(componentName string, appName string) (*corev1.Pod, error) {
+	pod, err := c.GetRunningPodFromSelector(odolabels.GetPodSelector(componentName, appName))
+	if err != nil {
+		return nil, err
+	}
+	if err = odolabels.CheckPodLabels(pod.GetLabels(), componentName, appName); err != nil {
+		return nil, err
+	}
+	return pod, nil
 }
 
 // GetRunningPodFromSelector gets a pod from the selector
 func (c *Client) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
-	pods, err := c.KubeClient.CoreV1().Pods(c.Namespace).List(context.TODO(), metav1.ListOptions{
-		LabelSelector: selector,
-		FieldSelector: "status.phase=Running",
+	var pods *corev1.PodList
+	err := c.retryTransient(func() (err error) {
+		pods, err = c.KubeClient.CoreV1().Pods(c.Namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: selector,
+			FieldSelector: "status.phase=Running",
+		})
+		return err
 	})
 	if err != nil {
 		// Don't wrap error since we want to know if it's a forbidden error
@@ -181,7 +193,12 @@ func matchOwnerReferenceWithResources(c ClientInterface, owner metav1.OwnerRefer
 }
 
 func (c *Client) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
-	return c.KubeClient.CoreV1().Pods(c.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	var pods *corev1.PodList
+	err := c.retryTransient(func() (err error) {
+		pods, err = c.KubeClient.CoreV1().Pods(c.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+		return err
+	})
+	return pods, err
 }
 
 func (c *Client) PodWatcher(ctx context.Context, selector string) (watch.Interface, error) {
@@ -16,6 +16,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	ktesting "k8s.io/client-go/testing"
+
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
 )
 
 func TestGetOnePodFromSelector(t *testing.T) {
@@ -109,24 +111,54 @@ func TestGetOnePodFromSelector(t *testing.T) {
 
 func TestGetPodUsingComponentName(t *testing.T) {
 	fakePod := FakePodStatus(corev1.PodRunning, "nodejs")
-	fakePod.Labels["component"] = "nodejs"
+	fakePod.Labels = odolabels.GetLabels("nodejs", "app", "", odolabels.ComponentDevMode, true)
+
+	// otherAppPod belongs to a component with the same name, but in another application
+	otherAppPod := FakePodStatus(corev1.PodRunning, "nodejs-other")
+	otherAppPod.Labels = odolabels.GetLabels("nodejs", "other", "", odolabels.ComponentDevMode, true)
+
+	// customPod is not managed by odo, but uses the same component label
+	customPod := FakePodStatus(corev1.PodRunning, "custom")
+	customPod.Labels["component"] = "nodejs"
 
 	type args struct {
 		componentName string
+		appName       string
 	}
 	tests := []struct {
-		name    string
-		args    args
-		want    *corev1.Pod
-		wantErr bool
+		name     string
+		args     args
+		listPods []corev1.Pod
+		want     *corev1.Pod
+		wantErr  bool
 	}{
 		{
 			name: "list called with same component name",
 			args: args{
 				componentName: "nodejs",
+				appName:       "app",
 			},
-			want:    fakePod,
-			wantErr: false,
+			listPods: []corev1.Pod{*fakePod},
+			want:     fakePod,
+			wantErr:  false,
+		},
+		{
+			name: "pod of a component with the same name in another application is rejected",
+			args: args{
+				componentName: "nodejs",
+				appName:       "app",
+			},
+			listPods: []corev1.Pod{*otherAppPod},
+			wantErr:  true,
+		},
+		{
+			name: "pod not managed by odo with the same component label is rejected",
+			args: args{
+				componentName: "nodejs",
+				appName:       "app",
+			},
+			listPods: []corev1.Pod{*customPod},
+			wantErr:  true,
 		},
 	}
 	for _, tt := range tests {
@@ -134,17 +166,17 @@ func TestGetPodUsingComponentName(t *testing.T) {
 			fkclient, fkclientset := FakeNew()
 
 			fkclientset.Kubernetes.PrependReactor("list", "pods", func(action ktesting.Action) (handled bool, ret runtime.Object, err error) {
-				if action.(ktesting.ListAction).GetListRestrictions().Labels.String() != fmt.Sprintf("component=%s", tt.args.componentName) {
-					t.Errorf("list called with different selector want:%s, got:%s", fmt.Sprintf("component=%s", tt.args.componentName), action.(ktesting.ListAction).GetListRestrictions().Labels.String())
+				wantSelector := odolabels.GetPodSelector(tt.args.componentName, tt.args.appName)
+				if got := action.(ktesting.ListAction).GetListRestrictions().Labels.String(); got != wantSelector {
+					t.Errorf("list called with different selector want:%s, got:%s", wantSelector, got)
 				}
+				// the reactor returns the pods regardless of the selector, to simulate pods wrongly matched
 				return true, &corev1.PodList{
-					Items: []corev1.Pod{
-						*fakePod,
-					},
+					Items: tt.listPods,
 				}, nil
 			})
 
-			got, err := fkclient.GetPodUsingComponentName(tt.args.componentName)
+			got, err := fkclient.GetPodUsingComponentName(tt.args.componentName, tt.args.appName)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetPodUsingComponentName() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	projectv1 "github.com/openshift/api/project/v1"
@@ -16,14 +18,72 @@ import (
 )
 
 const (
-	// timeout for waiting for project deletion
-	waitForProjectDeletionTimeOut = 3 * time.Minute
+	// maxProjectNameLength is the maximal length of a DNS-1123 label
+	maxProjectNameLength = 63
 )
 
+var (
+	projectNameCharsRegexp = regexp.MustCompile(`^[a-z0-9-]*$`)
+
+	// reservedProjectPrefixes are the prefixes of the project names reserved by the cluster
+	reservedProjectPrefixes = []string{"openshift-", "kube-"}
+	// reservedProjectNames are the project names reserved by the cluster
+	reservedProjectNames = []string{"default", "openshift"}
+)
+
+// ValidateProjectName checks that name can be used to create a new OpenShift project.
+// The name must be a DNS-1123 label and must not be reserved by the cluster
+func ValidateProjectName(name string) error {
+	if err := validateName("project", name); err != nil {
+		return err
+	}
+	for _, reserved := range reservedProjectNames {
+		if name == reserved {
+			return fmt.Errorf("invalid project name %q: %q is reserved by the cluster", name, reserved)
+		}
+	}
+	for _, prefix := range reservedProjectPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return fmt.Errorf("invalid project name %q: names starting with %q are reserved by the cluster", name, prefix)
+		}
+	}
+	return nil
+}
+
+// ValidateNamespaceName checks that name can be used as the name of a Kubernetes namespace,
+// i.e. that it is a DNS-1123 label. Contrary to project names, no name is reserved
+func ValidateNamespaceName(name string) error {
+	return validateName("namespace", name)
+}
+
+// validateName checks that name is a DNS-1123 label; kind is the kind of resource named, used in the error messages
+func validateName(kind string, name string) error {
+	if name == "" {
+		return fmt.Errorf("invalid %s name: name must not be empty", kind)
+	}
+	if len(name) > maxProjectNameLength {
+		return fmt.Errorf("invalid %s name %q: name must be no more than %d characters, got %d", kind, name, maxProjectNameLength, len(name))
+	}
+	if strings.ToLower(name) != name {
+		return fmt.Errorf("invalid %s name %q: name must not contain uppercase characters", kind, name)
+	}
+	if !projectNameCharsRegexp.MatchString(name) {
+		return fmt.Errorf("invalid %s name %q: name must contain only lowercase alphanumeric characters or '-'", kind, name)
+	}
+	if strings.HasPrefix(name, "-") || strings.HasSuffix(name, "-") {
+		return fmt.Errorf("invalid %s name %q: name must start and end with an alphanumeric character", kind, name)
+	}
+	return nil
+}
+
 // GetProject returns project based on the name of the project
 // errors related to project not being found or forbidden are translated to nil project for compatibility
 func (c *Client) GetProject(projectName string) (*projectv1.Project, error) {
-	prj, err := c.projectClient.Projects().Get(context.TODO(), projectName, metav1.GetOptions{})
+	var prj *projectv1.Project
+	err := c.retryTransient(func() (err error) {
+		prj, err = c.projectClient.Projects().Get(context.TODO(), projectName, metav1.GetOptions{})
+		return err
+	})
 	if err != nil {
 		istatus, ok := err.(kerrors.APIStatus)
 		if ok {
@@ -42,7 +102,12 @@ func (c *Client) GetProject(projectName string) (*projectv1.Project, error) {
 
 // ListProjects return list of existing projects that user has access to.
 func (c *Client) ListProjects() (*projectv1.ProjectList, error) {
-	return c.projectClient.Projects().List(context.TODO(), metav1.ListOptions{})
+	var projects *projectv1.ProjectList
+	err := c.retryTransient(func() (err error) {
+		projects, err = c.projectClient.Projects().List(context.TODO(), metav1.ListOptions{})
+		return err
+	})
+	return projects, err
 }
 
 // ListProjectNames return list of existing project names that user has access to.
@@ -148,8 +213,8 @@ func (c *Client) DeleteProject(name string, wait bool) error {
 			return nil
 		case err := <-watchErrorChannel:
 			return err
-		case <-time.After(waitForProjectDeletionTimeOut):
-			return fmt.Errorf("waited %s but couldn't delete project %s in time", waitForProjectDeletionTimeOut, name)
+		case <-time.After(c.timeouts().ResourceDeletion):
+			return fmt.Errorf("waited %s but couldn't delete project %s in time", c.timeouts().ResourceDeletion, name)
 		}
 
 	}
@@ -160,6 +225,10 @@ func (c *Client) DeleteProject(name string, wait bool) error {
 
 // CreateNewProject creates project with given projectName
 func (c *Client) CreateNewProject(projectName string, wait bool) error {
+	if err := ValidateProjectName(projectName); err != nil {
+		return err
+	}
+
 	// Instantiate watcher before requesting new project
 	// If watcher is created after the project it can lead to situation when the project is created before the watcher.
 	// When this happens, it gets stuck waiting for event that already happened.
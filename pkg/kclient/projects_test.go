@@ -2,6 +2,7 @@ package kclient
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -34,6 +35,12 @@ func TestCreateNewProject(t *testing.T) {
 			wait:     true,
 			wantErr:  false,
 		},
+		{
+			name:     "Case 3: invalid project name",
+			projName: "Testing_3",
+			wait:     false,
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -75,6 +82,12 @@ func TestCreateNewProject(t *testing.T) {
 
 			actions := fkclientset.ProjClientset.Actions()
 			actionsLen := len(actions)
+			if tt.wantErr {
+				if actionsLen != 0 {
+					t.Errorf("expected no action in CreateNewProject for an invalid name got: %v", actions)
+				}
+				return
+			}
 			if !tt.wait && actionsLen != 1 {
 				t.Errorf("expected 1 action in CreateNewProject got: %v", actions)
 			}
@@ -186,3 +199,70 @@ func TestListProjectNames(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateProjectName(t *testing.T) {
+	tests := []struct {
+		name        string
+		projName    string
+		wantErr     bool
+		errContains string
+	}{
+		{name: "valid name", projName: "my-project-1"},
+		{name: "single character", projName: "a"},
+		{name: "63 characters", projName: strings.Repeat("a", 63)},
+		{name: "name containing a reserved word", projName: "my-openshift-project"},
+		{name: "empty", projName: "", wantErr: true, errContains: "must not be empty"},
+		{name: "too long", projName: strings.Repeat("a", 64), wantErr: true, errContains: "no more than 63 characters"},
+		{name: "uppercase", projName: "MyProject", wantErr: true, errContains: "uppercase"},
+		{name: "underscore", projName: "my_project", wantErr: true, errContains: "only lowercase alphanumeric characters or '-'"},
+		{name: "dot", projName: "my.project", wantErr: true, errContains: "only lowercase alphanumeric characters or '-'"},
+		{name: "leading dash", projName: "-project", wantErr: true, errContains: "start and end with an alphanumeric character"},
+		{name: "trailing dash", projName: "project-", wantErr: true, errContains: "start and end with an alphanumeric character"},
+		{name: "default", projName: "default", wantErr: true, errContains: "reserved"},
+		{name: "openshift", projName: "openshift", wantErr: true, errContains: "reserved"},
+		{name: "openshift- prefix", projName: "openshift-monitoring", wantErr: true, errContains: `starting with "openshift-"`},
+		{name: "kube- prefix", projName: "kube-system", wantErr: true, errContains: `starting with "kube-"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateProjectName(tt.projName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateProjectName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("ValidateProjectName() error = %q, expected to contain %q", err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestValidateNamespaceName(t *testing.T) {
+	tests := []struct {
+		name        string
+		nsName      string
+		wantErr     bool
+		errContains string
+	}{
+		{name: "valid name", nsName: "my-namespace-1"},
+		// the names reserved by OpenShift can be used on other clusters
+		{name: "default", nsName: "default"},
+		{name: "openshift- prefix", nsName: "openshift-monitoring"},
+		{name: "kube- prefix", nsName: "kube-tools"},
+		{name: "empty", nsName: "", wantErr: true, errContains: "invalid namespace name: name must not be empty"},
+		{name: "too long", nsName: strings.Repeat("a", 64), wantErr: true, errContains: "no more than 63 characters"},
+		{name: "uppercase", nsName: "MyNamespace", wantErr: true, errContains: "uppercase"},
+		{name: "underscore", nsName: "my_namespace", wantErr: true, errContains: "only lowercase alphanumeric characters or '-'"},
+		{name: "trailing dash", nsName: "namespace-", wantErr: true, errContains: "start and end with an alphanumeric character"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNamespaceName(tt.nsName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateNamespaceName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("ValidateNamespaceName() error = %q, expected to contain %q", err, tt.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package kclient
+
+import (
+	"strings"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog"
+)
+
+const (
+	// retryInitialDelay is the delay before the first retry of an operation failing with a transient error,
+	// doubled after each attempt up to retryMaxDelay
+	retryInitialDelay = 200 * time.Millisecond
+	retryMaxDelay     = 5 * time.Second
+	// retryJitter is the maximal random part added to the delays, as a factor of the delay
+	retryJitter = 0.5
+)
+
+// isTransient returns true if the error is likely to disappear if the operation is retried:
+// throttling by the API server, timeouts of the API server or etcd, and connections reset
+func isTransient(err error) bool {
+	switch {
+	case kerrors.IsTooManyRequests(err),
+		kerrors.IsServerTimeout(err),
+		kerrors.IsServiceUnavailable(err),
+		kerrors.IsTimeout(err):
+		return true
+	case kerrors.IsInternalError(err):
+		return strings.Contains(err.Error(), "etcdserver: request timed out")
+	}
+	return utilnet.IsConnectionReset(err) || utilnet.IsProbableEOF(err) || utilnet.IsTimeout(err)
+}
+
+// retryTransient executes the operation, and retries it while it fails with a transient error,
+// during the Retry timeout at most.
+// Only read-only and idempotent operations must be retried: a non-idempotent operation
+// failing with a transient error may have been executed by the cluster.
+func (c *Client) retryTransient(operation func() error) error {
+	return retryOnTransientError(c.timeouts().Retry, retryInitialDelay, operation)
+}
+
+// retryOnTransientError executes the operation, and retries it with an exponential backoff starting at initialDelay,
+// while it fails with a transient error and maxElapsed is not reached. The last error is returned
+func retryOnTransientError(maxElapsed time.Duration, initialDelay time.Duration, operation func() error) error {
+	start := time.Now()
+	delay := initialDelay
+	for attempt := 1; ; attempt++ {
+		err := operation()
+		if err == nil || !isTransient(err) {
+			return err
+		}
+		sleep := wait.Jitter(delay, retryJitter)
+		if time.Since(start)+sleep > maxElapsed {
+			return err
+		}
+		klog.V(4).Infof("transient error on attempt %d, retrying in %s: %v", attempt, sleep, err)
+		time.Sleep(sleep)
+		delay *= 2
+		if delay > retryMaxDelay {
+			delay = retryMaxDelay
+		}
+	}
+}
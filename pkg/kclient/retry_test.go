@@ -0,0 +1,152 @@
+package kclient
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ktesting "k8s.io/client-go/testing"
+)
+
+var secretsResource = schema.GroupResource{Resource: "secrets"}
+
+func Test_isTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "too many requests", err: kerrors.NewTooManyRequests("slow down", 1), want: true},
+		{name: "server timeout", err: kerrors.NewServerTimeout(secretsResource, "list", 1), want: true},
+		{name: "service unavailable", err: kerrors.NewServiceUnavailable("unavailable"), want: true},
+		{name: "gateway timeout", err: kerrors.NewTimeoutError("timeout", 1), want: true},
+		{name: "etcd request timed out", err: kerrors.NewInternalError(errors.New("etcdserver: request timed out")), want: true},
+		{name: "connection reset", err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}, want: true},
+		{name: "unexpected EOF", err: &url.Error{Op: "Get", URL: "https://api.example.com:6443/api", Err: io.ErrUnexpectedEOF}, want: true},
+		{name: "other internal error", err: kerrors.NewInternalError(errors.New("boom")), want: false},
+		{name: "not found", err: kerrors.NewNotFound(secretsResource, "foo"), want: false},
+		{name: "forbidden", err: kerrors.NewForbidden(secretsResource, "foo", errors.New("denied")), want: false},
+		{name: "invalid", err: kerrors.NewInvalid(schema.GroupKind{Kind: "Secret"}, "foo", field.ErrorList{}), want: false},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, want: false},
+		{name: "other error", err: errors.New("something went wrong"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransient(tt.err); got != tt.want {
+				t.Errorf("isTransient() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_retryOnTransientError(t *testing.T) {
+	transient := kerrors.NewTooManyRequests("slow down", 1)
+	forbidden := kerrors.NewForbidden(secretsResource, "foo", errors.New("denied"))
+	tests := []struct {
+		name       string
+		maxElapsed time.Duration
+		// errs are the errors returned by the successive calls; the calls succeed after the last error
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:       "success at first call",
+			maxElapsed: time.Second,
+			wantCalls:  1,
+		},
+		{
+			name:       "success after transient errors",
+			maxElapsed: time.Second,
+			errs:       []error{transient, transient},
+			wantCalls:  3,
+		},
+		{
+			name:       "forbidden error is not retried",
+			maxElapsed: time.Second,
+			errs:       []error{forbidden},
+			wantCalls:  1,
+			wantErr:    forbidden,
+		},
+		{
+			name:       "non-transient error after a transient error is not retried",
+			maxElapsed: time.Second,
+			errs:       []error{transient, forbidden, transient},
+			wantCalls:  2,
+			wantErr:    forbidden,
+		},
+		{
+			name:       "last transient error is returned when the maximal elapsed time is reached",
+			maxElapsed: 10 * time.Millisecond,
+			errs:       []error{transient, transient, transient, transient, transient, transient, transient, transient},
+			wantErr:    transient,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := retryOnTransientError(tt.maxElapsed, time.Millisecond, func() error {
+				calls++
+				if calls <= len(tt.errs) {
+					return tt.errs[calls-1]
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantCalls != 0 && calls != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+			if tt.wantCalls == 0 && calls >= len(tt.errs) {
+				t.Errorf("expected the retries to stop before the operation succeeds, got %d calls", calls)
+			}
+		})
+	}
+}
+
+func TestClient_retryTransient(t *testing.T) {
+	client, fakeClientSet := FakeNew()
+	client.Namespace = "ns"
+	client.Timeouts = Timeouts{Retry: 5 * time.Second}
+
+	listCalls := 0
+	fakeClientSet.Kubernetes.PrependReactor("list", "secrets", func(action ktesting.Action) (bool, runtime.Object, error) {
+		listCalls++
+		if listCalls <= 2 {
+			return true, nil, kerrors.NewServiceUnavailable("etcd leader election")
+		}
+		return true, &corev1.SecretList{Items: []corev1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}}}, nil
+	})
+	createCalls := 0
+	fakeClientSet.Kubernetes.PrependReactor("create", "secrets", func(action ktesting.Action) (bool, runtime.Object, error) {
+		createCalls++
+		return true, nil, kerrors.NewTooManyRequests("slow down", 1)
+	})
+
+	secrets, err := client.ListSecrets("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(secrets) != 1 || listCalls != 3 {
+		t.Errorf("expected the list of secrets after 3 calls, got %d secrets after %d calls", len(secrets), listCalls)
+	}
+
+	err = client.CreateSecret(metav1.ObjectMeta{Name: "bar"}, map[string]string{}, metav1.OwnerReference{})
+	if err == nil {
+		t.Fatalf("expected an error when creating the secret")
+	}
+	if createCalls != 1 {
+		t.Errorf("the creation of a resource must not be retried, got %d calls", createCalls)
+	}
+}
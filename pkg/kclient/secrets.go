@@ -8,15 +8,19 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/fields"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog"
 
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -43,7 +47,7 @@ func (c *Client) CreateTLSSecret(tlsCertificate []byte, tlsPrivKey []byte, objec
 
 	secret, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Create(context.TODO(), &secretTemplate, metav1.CreateOptions{FieldManager: FieldManager})
 	if err != nil {
-		return nil, fmt.Errorf("unable to create secret %s: %w", objectMeta.Name, err)
+		return nil, newResourceError("create", "secret", objectMeta.Name, c.Namespace, err)
 	}
 	return secret, nil
 }
@@ -101,46 +105,101 @@ func GenerateSelfSignedCertificate(host string) (SelfSignedCertificate, error) {
 
 // GetSecret returns the Secret object in the given namespace
 func (c *Client) GetSecret(name, namespace string) (*corev1.Secret, error) {
-	secret, err := c.KubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	var secret *corev1.Secret
+	err := c.retryTransient(func() (err error) {
+		secret, err = c.KubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to get the secret %s: %w", secret, err)
+		return nil, newResourceError("get", "secret", name, namespace, err)
 	}
 	return secret, nil
 }
 
 // UpdateSecret updates the given Secret object in the given namespace
 func (c *Client) UpdateSecret(secret *corev1.Secret, namespace string) (*corev1.Secret, error) {
-	secret, err := c.KubeClient.CoreV1().Secrets(namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+	updated, err := c.KubeClient.CoreV1().Secrets(namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("unable to update the secret %s: %w", secret, err)
+		return nil, newResourceError("update", "secret", secret.GetName(), namespace, err)
 	}
-	return secret, nil
+	return updated, nil
 }
 
-// DeleteSecret updates the given Secret object in the given namespace
+// DeleteSecret deletes the given Secret object in the given namespace
 func (c *Client) DeleteSecret(secretName, namespace string) error {
 	err := c.KubeClient.CoreV1().Secrets(namespace).Delete(context.TODO(), secretName, metav1.DeleteOptions{})
 	if err != nil {
-		return fmt.Errorf("unable to delete the secret %s: %w", secretName, err)
+		return newResourceError("delete", "secret", secretName, namespace, err)
 	}
 	return nil
 }
 
-// CreateSecret generates and creates the secret
+// CreateSecret generates and creates an opaque secret
 // commonObjectMeta is the ObjectMeta for the service
 func (c *Client) CreateSecret(objectMeta metav1.ObjectMeta, data map[string]string, ownerReference metav1.OwnerReference) error {
-
-	secret := corev1.Secret{
+	_, err := c.createSecret(corev1.Secret{
 		ObjectMeta: objectMeta,
 		Type:       corev1.SecretTypeOpaque,
 		StringData: data,
+	}, ownerReference)
+	return err
+}
+
+// CreateTypedSecret creates a secret of the given type, containing the binary data.
+// objectMeta must contain labels, so that the secret can be found and deleted with the resources it belongs to.
+func (c *Client) CreateTypedSecret(objectMeta metav1.ObjectMeta, secretType corev1.SecretType, data map[string][]byte, ownerReference metav1.OwnerReference) (*corev1.Secret, error) {
+	if objectMeta.Name == "" {
+		return nil, errors.New("secret name is empty")
+	}
+	if len(objectMeta.Labels) == 0 {
+		return nil, fmt.Errorf("unable to create secret %s: no labels defined", objectMeta.Name)
 	}
+	return c.createSecret(corev1.Secret{
+		ObjectMeta: objectMeta,
+		Type:       secretType,
+		Data:       data,
+	}, ownerReference)
+}
+
+// createSecret creates the secret in the current namespace, owned by ownerReference
+func (c *Client) createSecret(secret corev1.Secret, ownerReference metav1.OwnerReference) (*corev1.Secret, error) {
 	secret.SetOwnerReferences(append(secret.GetOwnerReferences(), ownerReference))
-	_, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Create(context.TODO(), &secret, metav1.CreateOptions{FieldManager: FieldManager})
+	created, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Create(context.TODO(), &secret, metav1.CreateOptions{FieldManager: FieldManager})
 	if err != nil {
-		return fmt.Errorf("unable to create secret for %s: %w", objectMeta.Name, err)
+		return nil, newResourceError("create", "secret", secret.Name, c.Namespace, err)
 	}
-	return nil
+	return created, nil
+}
+
+// UpdateSecretData replaces the data of the secret in the current namespace.
+// The update is retried on conflict, so that the data can be rotated while the secret is modified by others.
+func (c *Client) UpdateSecretData(name string, data map[string][]byte) (*corev1.Secret, error) {
+	var (
+		updated *corev1.Secret
+		// getErr is set when the secret cannot be read, to report the failing operation
+		getErr error
+	)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			getErr = err
+			return err
+		}
+		secret.Data = data
+		secret.StringData = nil
+		updated, err = c.KubeClient.CoreV1().Secrets(c.Namespace).Update(context.TODO(), secret, metav1.UpdateOptions{FieldManager: FieldManager})
+		if kerrors.IsConflict(err) {
+			klog.V(4).Infof("conflict updating secret %s, retrying", name)
+		}
+		return err
+	})
+	if getErr != nil {
+		return nil, newResourceError("get", "secret", name, c.Namespace, getErr)
+	}
+	if err != nil {
+		return nil, newResourceError("update", "secret", name, c.Namespace, err)
+	}
+	return updated, nil
 }
 
 // CreateSecrets creates a secret for each port, containing the host and port of the component
@@ -160,11 +219,12 @@ func (c *Client) CreateSecrets(componentName string, commonObjectMeta metav1.Obj
 		// of a component based on the port
 		commonObjectMeta.Annotations[ComponentPortAnnotationName] = portAsString
 
-		err := c.CreateSecret(
+		_, err := c.CreateTypedSecret(
 			commonObjectMeta,
-			map[string]string{
-				secretKeyName(componentName, "host"): svc.Name,
-				secretKeyName(componentName, "port"): portAsString,
+			corev1.SecretTypeOpaque,
+			map[string][]byte{
+				secretKeyName(componentName, "host"): []byte(svc.Name),
+				secretKeyName(componentName, "port"): []byte(portAsString),
 			},
 			ownerReference)
 
@@ -189,15 +249,24 @@ func (c *Client) ListSecrets(labelSelector string) ([]corev1.Secret, error) {
 		}
 	}
 
-	secretList, err := c.KubeClient.CoreV1().Secrets(c.Namespace).List(context.TODO(), listOptions)
+	var secretList *corev1.SecretList
+	err := c.retryTransient(func() (err error) {
+		secretList, err = c.KubeClient.CoreV1().Secrets(c.Namespace).List(context.TODO(), listOptions)
+		return err
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to get secret list: %w", err)
+		return nil, fmt.Errorf("unable to get secret list in namespace %q: %w", c.Namespace, err)
 	}
 
 	return secretList.Items, nil
 }
 
-// WaitAndGetSecret blocks and waits until the secret is available
+// GetSecretsByLabel returns the secrets of the current namespace having all the given labels
+func (c *Client) GetSecretsByLabel(labels map[string]string) ([]corev1.Secret, error) {
+	return c.ListSecrets(k8slabels.SelectorFromSet(labels).String())
+}
+
+// WaitAndGetSecret blocks and waits until the secret is available, or the Secret timeout is reached
 func (c *Client) WaitAndGetSecret(name string, namespace string) (*corev1.Secret, error) {
 	klog.V(3).Infof("Waiting for secret %s to become available", name)
 
@@ -205,20 +274,24 @@ func (c *Client) WaitAndGetSecret(name string, namespace string) (*corev1.Secret
 		FieldSelector: fields.Set{"metadata.name": name}.AsSelector().String(),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to watch secret: %w", err)
+		return nil, newResourceError("watch", "secret", name, namespace, err)
 	}
 	defer w.Stop()
+	timeout := time.After(c.timeouts().Secret)
 	for {
-		val, ok := <-w.ResultChan()
-		if !ok {
-			break
-		}
-		if e, ok := val.Object.(*corev1.Secret); ok {
-			klog.V(3).Infof("Secret %s now exists", e.Name)
-			return e, nil
+		select {
+		case val, ok := <-w.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("unknown error while waiting for secret %q in namespace %q", name, namespace)
+			}
+			if e, ok := val.Object.(*corev1.Secret); ok {
+				klog.V(3).Infof("Secret %s now exists", e.Name)
+				return e, nil
+			}
+		case <-timeout:
+			return nil, fmt.Errorf("waited %s but secret %q in namespace %q is still not available", c.timeouts().Secret, name, namespace)
 		}
 	}
-	return nil, fmt.Errorf("unknown error while waiting for secret '%s'", name)
 }
 
 func secretKeyName(componentName, baseKeyName string) string {
@@ -1,6 +1,7 @@
 package kclient
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -8,10 +9,12 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	ktesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/retry"
 )
 
 func TestCreateTLSSecret(t *testing.T) {
@@ -171,6 +174,130 @@ func TestGetSecret(t *testing.T) {
 	}
 }
 
+func TestSecretErrors(t *testing.T) {
+	secretGR := corev1.Resource("secrets")
+	operations := []struct {
+		name string
+		verb string
+		call func(c *Client) error
+		// wantPrefix is the expected message for errors other than NotFound and Forbidden, without the wrapped error
+		wantPrefix string
+	}{
+		{
+			name: "GetSecret",
+			verb: "get",
+			call: func(c *Client) error {
+				_, err := c.GetSecret("foo", "ns")
+				return err
+			},
+			wantPrefix: `unable to get the secret "foo" in namespace "ns": `,
+		},
+		{
+			name: "UpdateSecret",
+			verb: "update",
+			call: func(c *Client) error {
+				_, err := c.UpdateSecret(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, "ns")
+				return err
+			},
+			wantPrefix: `unable to update the secret "foo" in namespace "ns": `,
+		},
+		{
+			name: "DeleteSecret",
+			verb: "delete",
+			call: func(c *Client) error {
+				return c.DeleteSecret("foo", "ns")
+			},
+			wantPrefix: `unable to delete the secret "foo" in namespace "ns": `,
+		},
+		{
+			name: "UpdateSecretData",
+			verb: "get",
+			call: func(c *Client) error {
+				_, err := c.UpdateSecretData("foo", nil)
+				return err
+			},
+			wantPrefix: `unable to get the secret "foo" in namespace "ns": `,
+		},
+	}
+	for _, op := range operations {
+		t.Run(op.name, func(t *testing.T) {
+			for _, tt := range []struct {
+				name    string
+				err     error
+				wantErr string
+				check   func(error) bool
+			}{
+				{
+					name:    "not found",
+					err:     kerrors.NewNotFound(secretGR, "foo"),
+					wantErr: `secret "foo" not found in namespace "ns"`,
+					check: func(err error) bool {
+						var target *ResourceNotFoundError
+						return errors.As(err, &target) && kerrors.IsNotFound(err)
+					},
+				},
+				{
+					name:    "forbidden",
+					err:     kerrors.NewForbidden(secretGR, "foo", errors.New("no access")),
+					wantErr: `access to secret "foo" in namespace "ns" is forbidden: secrets "foo" is forbidden: no access`,
+					check: func(err error) bool {
+						var target *ResourceForbiddenError
+						return errors.As(err, &target) && kerrors.IsForbidden(err)
+					},
+				},
+				{
+					name:    "transport error",
+					err:     errors.New("connection refused"),
+					wantErr: op.wantPrefix + "connection refused",
+					check: func(err error) bool {
+						var notFound *ResourceNotFoundError
+						var forbidden *ResourceForbiddenError
+						return !errors.As(err, &notFound) && !errors.As(err, &forbidden)
+					},
+				},
+			} {
+				t.Run(tt.name, func(t *testing.T) {
+					fakeClient, fakeClientSet := FakeNew()
+					fakeClient.Namespace = "ns"
+					fakeClientSet.Kubernetes.PrependReactor(op.verb, "secrets", func(action ktesting.Action) (bool, runtime.Object, error) {
+						return true, nil, tt.err
+					})
+
+					err := op.call(fakeClient)
+					if err == nil {
+						t.Fatal("expected an error")
+					}
+					if err.Error() != tt.wantErr {
+						t.Errorf("expected error %q, got %q", tt.wantErr, err.Error())
+					}
+					if !tt.check(err) {
+						t.Errorf("unexpected type for error %#v", err)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestGetSecretsByLabel(t *testing.T) {
+	fakeClient, fakeClientSet := FakeNew()
+	fakeClientSet.Kubernetes.PrependReactor("list", "secrets", func(action ktesting.Action) (bool, runtime.Object, error) {
+		selector := action.(ktesting.ListAction).GetListRestrictions().Labels.String()
+		if selector != "app=app,component=comp" {
+			return true, nil, fmt.Errorf("unexpected selector %q", selector)
+		}
+		return true, &corev1.SecretList{Items: []corev1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"app": "app", "component": "comp"}}}}}, nil
+	})
+
+	secrets, err := fakeClient.GetSecretsByLabel(map[string]string{"component": "comp", "app": "app"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(secrets) != 1 || secrets[0].Name != "foo" {
+		t.Errorf("unexpected secrets: %v", secrets)
+	}
+}
+
 func TestListSecrets(t *testing.T) {
 
 	tests := []struct {
@@ -301,3 +428,150 @@ func TestWaitAndGetSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTypedSecret(t *testing.T) {
+	ownerReference := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "my-component-app",
+	}
+	labels := map[string]string{
+		"app.kubernetes.io/instance": "my-component",
+	}
+	tests := []struct {
+		name       string
+		objectMeta metav1.ObjectMeta
+		secretType corev1.SecretType
+		data       map[string][]byte
+		wantErr    bool
+	}{
+		{
+			name:       "TLS secret",
+			objectMeta: metav1.ObjectMeta{Name: "my-tls", Labels: labels},
+			secretType: corev1.SecretTypeTLS,
+			data: map[string][]byte{
+				corev1.TLSCertKey:       []byte("cert"),
+				corev1.TLSPrivateKeyKey: []byte("key"),
+			},
+		},
+		{
+			name:       "binary data",
+			objectMeta: metav1.ObjectMeta{Name: "my-keystore", Labels: labels},
+			secretType: corev1.SecretTypeOpaque,
+			data: map[string][]byte{
+				"keystore.jks": {0xfe, 0xed, 0xfe, 0xed, 0x00, 0x00, 0x00, 0x02, 0xff},
+			},
+		},
+		{
+			name:       "no labels",
+			objectMeta: metav1.ObjectMeta{Name: "my-secret"},
+			secretType: corev1.SecretTypeOpaque,
+			wantErr:    true,
+		},
+		{
+			name:       "no name",
+			objectMeta: metav1.ObjectMeta{Labels: labels},
+			secretType: corev1.SecretTypeOpaque,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, fkclientset := FakeNew()
+			fkclient.Namespace = "default"
+
+			got, err := fkclient.CreateTypedSecret(tt.objectMeta, tt.secretType, tt.data, ownerReference)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateTypedSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(fkclientset.Kubernetes.Actions()) != 0 {
+					t.Errorf("expected no action, got: %v", fkclientset.Kubernetes.Actions())
+				}
+				return
+			}
+
+			// read the secret back from the cluster
+			secret, err := fkclient.GetSecret(tt.objectMeta.Name, "default")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(got, secret); diff != "" {
+				t.Errorf("CreateTypedSecret() mismatch (-want +got):\n%s", diff)
+			}
+			if secret.Type != tt.secretType {
+				t.Errorf("expected type %q, got %q", tt.secretType, secret.Type)
+			}
+			if diff := cmp.Diff(tt.data, secret.Data); diff != "" {
+				t.Errorf("data mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(labels, secret.Labels); diff != "" {
+				t.Errorf("labels mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]metav1.OwnerReference{ownerReference}, secret.OwnerReferences); diff != "" {
+				t.Errorf("owner references mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateSecretData(t *testing.T) {
+	tests := []struct {
+		name      string
+		conflicts int
+		wantErr   bool
+	}{
+		{
+			name: "no conflict",
+		},
+		{
+			name:      "conflicts are retried",
+			conflicts: 2,
+		},
+		{
+			name:      "too many conflicts",
+			conflicts: retry.DefaultRetry.Steps,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, fkclientset := FakeNew()
+			fkclient.Namespace = "default"
+			_, err := fkclient.CreateTypedSecret(
+				metav1.ObjectMeta{Name: "my-secret", Labels: map[string]string{"app": "app"}},
+				corev1.SecretTypeOpaque,
+				map[string][]byte{"password": []byte("old")},
+				metav1.OwnerReference{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			conflicts := 0
+			fkclientset.Kubernetes.PrependReactor("update", "secrets", func(action ktesting.Action) (bool, runtime.Object, error) {
+				if conflicts < tt.conflicts {
+					conflicts++
+					return true, nil, kerrors.NewConflict(corev1.Resource("secrets"), "my-secret", fmt.Errorf("the object has been modified"))
+				}
+				return false, nil, nil
+			})
+
+			_, err = fkclient.UpdateSecretData("my-secret", map[string][]byte{"password": []byte("new")})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdateSecretData() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			secret, err := fkclient.GetSecret("my-secret", "default")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := "new"
+			if tt.wantErr {
+				want = "old"
+			}
+			if got := string(secret.Data["password"]); got != want {
+				t.Errorf("expected password %q, got %q", want, got)
+			}
+		})
+	}
+}
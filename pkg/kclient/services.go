@@ -32,8 +32,12 @@ func (c *Client) UpdateService(svc corev1.Service) (*corev1.Service, error) {
 // ListServices returns an array of Service resources which match the
 // given selector
 func (c *Client) ListServices(selector string) ([]corev1.Service, error) {
-	serviceList, err := c.KubeClient.CoreV1().Services(c.Namespace).List(context.TODO(), metav1.ListOptions{
-		LabelSelector: selector,
+	var serviceList *corev1.ServiceList
+	err := c.retryTransient(func() (err error) {
+		serviceList, err = c.KubeClient.CoreV1().Services(c.Namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		return err
 	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to list Services: %w", err)
@@ -74,3 +78,58 @@ func (c *Client) GetOneServiceFromSelector(selector string) (*corev1.Service, er
 
 	return &services[0], nil
 }
+
+// LinkTarget describes how another component of the cluster can reach a component through its Service
+type LinkTarget struct {
+	// ServiceName is the name of the Service of the component
+	ServiceName string
+	// DNSName is the DNS name of the Service resolved inside the cluster
+	DNSName string
+	// HostEnvName and PortEnvName are the names of the environment variables injected from the secrets
+	// created by CreateSecrets. The names do not depend on the port: each port has its own secret,
+	// all of them using the same keys.
+	HostEnvName string
+	PortEnvName string
+	Ports       []LinkTargetPort
+}
+
+// LinkTargetPort describes a port of the Service of a component
+type LinkTargetPort struct {
+	Name     string
+	Port     int32
+	Protocol corev1.Protocol
+	// Address is the address of the port inside the cluster, as <DNS name>:<port>
+	Address string
+}
+
+// GetLinkTarget returns how the component can be reached by the other components of the cluster, using its Service.
+// The Service is searched for the given mode, odolabels.ComponentAnyMode matching the Service deployed by any command.
+// Nothing is modified in the cluster. A ServiceNotLinkableError is returned if the Service is headless or has no ports.
+func (c *Client) GetLinkTarget(componentName, appName, mode string) (*LinkTarget, error) {
+	svc, err := c.GetOneServiceFromSelector(odolabels.GetSelector(componentName, appName, mode, true))
+	if err != nil {
+		return nil, err
+	}
+	if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		return nil, &ServiceNotLinkableError{Name: svc.Name, Reason: "the service is headless"}
+	}
+	if len(svc.Spec.Ports) == 0 {
+		return nil, &ServiceNotLinkableError{Name: svc.Name, Reason: "the service does not expose any port"}
+	}
+
+	target := &LinkTarget{
+		ServiceName: svc.Name,
+		DNSName:     fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace),
+		HostEnvName: secretKeyName(componentName, "host"),
+		PortEnvName: secretKeyName(componentName, "port"),
+	}
+	for _, port := range svc.Spec.Ports {
+		target.Ports = append(target.Ports, LinkTargetPort{
+			Name:     port.Name,
+			Port:     port.Port,
+			Protocol: port.Protocol,
+			Address:  fmt.Sprintf("%s:%d", target.DNSName, port.Port),
+		})
+	}
+	return target, nil
+}
@@ -1,6 +1,7 @@
 package kclient
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -320,3 +321,122 @@ func TestClient_GetOneServiceFromSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetLinkTarget(t *testing.T) {
+	newService := func(clusterIP string, ports ...corev1.ServicePort) corev1.Service {
+		svc := odoTestingUtil.FakeKubeService("my-nodejs", "my-nodejs-app")
+		svc.Namespace = "project"
+		svc.Labels = odolabels.GetLabels("my-nodejs", "app", "", odolabels.ComponentDevMode, true)
+		svc.Spec.ClusterIP = clusterIP
+		svc.Spec.Ports = ports
+		return svc
+	}
+	httpPort := corev1.ServicePort{Name: "http-3000", Port: 3000, Protocol: corev1.ProtocolTCP}
+	debugPort := corev1.ServicePort{Name: "debug-5858", Port: 5858, Protocol: corev1.ProtocolTCP}
+	dnsPort := corev1.ServicePort{Name: "dns-53", Port: 53, Protocol: corev1.ProtocolUDP}
+
+	tests := []struct {
+		name     string
+		mode     string
+		services []corev1.Service
+		want     *LinkTarget
+		wantErr  bool
+	}{
+		{
+			name:     "service with multiple ports",
+			mode:     odolabels.ComponentDevMode,
+			services: []corev1.Service{newService("10.0.0.1", httpPort, debugPort, dnsPort)},
+			want: &LinkTarget{
+				ServiceName: "my-nodejs-app",
+				DNSName:     "my-nodejs-app.project.svc",
+				HostEnvName: "COMPONENT_MY_NODEJS_HOST",
+				PortEnvName: "COMPONENT_MY_NODEJS_PORT",
+				Ports: []LinkTargetPort{
+					{
+						Name:     "http-3000",
+						Port:     3000,
+						Protocol: corev1.ProtocolTCP,
+						Address:  "my-nodejs-app.project.svc:3000",
+					},
+					{
+						Name:     "debug-5858",
+						Port:     5858,
+						Protocol: corev1.ProtocolTCP,
+						Address:  "my-nodejs-app.project.svc:5858",
+					},
+					{
+						Name:     "dns-53",
+						Port:     53,
+						Protocol: corev1.ProtocolUDP,
+						Address:  "my-nodejs-app.project.svc:53",
+					},
+				},
+			},
+		},
+		{
+			name:     "service searched in any mode",
+			mode:     odolabels.ComponentAnyMode,
+			services: []corev1.Service{newService("10.0.0.1", httpPort)},
+			want: &LinkTarget{
+				ServiceName: "my-nodejs-app",
+				DNSName:     "my-nodejs-app.project.svc",
+				HostEnvName: "COMPONENT_MY_NODEJS_HOST",
+				PortEnvName: "COMPONENT_MY_NODEJS_PORT",
+				Ports: []LinkTargetPort{
+					{
+						Name:     "http-3000",
+						Port:     3000,
+						Protocol: corev1.ProtocolTCP,
+						Address:  "my-nodejs-app.project.svc:3000",
+					},
+				},
+			},
+		},
+		{
+			name:     "headless service",
+			mode:     odolabels.ComponentDevMode,
+			services: []corev1.Service{newService(corev1.ClusterIPNone, httpPort)},
+			wantErr:  true,
+		},
+		{
+			name:     "service without ports",
+			mode:     odolabels.ComponentDevMode,
+			services: []corev1.Service{newService("10.0.0.1")},
+			wantErr:  true,
+		},
+		{
+			name:    "no service",
+			mode:    odolabels.ComponentDevMode,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, fkclientset := FakeNew()
+			fkclient.Namespace = "project"
+
+			fkclientset.Kubernetes.PrependReactor("list", "services", func(action ktesting.Action) (bool, runtime.Object, error) {
+				wantSelector := odolabels.GetSelector("my-nodejs", "app", tt.mode, true)
+				if got := action.(ktesting.ListAction).GetListRestrictions().Labels.String(); got != wantSelector {
+					t.Errorf("list called with different selector want:%s, got:%s", wantSelector, got)
+				}
+				return true, &corev1.ServiceList{Items: tt.services}, nil
+			})
+
+			got, err := fkclient.GetLinkTarget("my-nodejs", "app", tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetLinkTarget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Client.GetLinkTarget() mismatch (-want +got):\n%s", diff)
+			}
+			if len(tt.services) == 1 && tt.wantErr {
+				var notLinkable *ServiceNotLinkableError
+				if !errors.As(err, &notLinkable) {
+					t.Errorf("expected a ServiceNotLinkableError, got %v", err)
+				}
+			}
+		})
+	}
+}
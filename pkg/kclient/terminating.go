@@ -0,0 +1,206 @@
+package kclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+)
+
+// pvcProtectionFinalizer is the finalizer added to the PVCs, preventing their deletion while they are mounted by a pod
+const pvcProtectionFinalizer = "kubernetes.io/pvc-protection"
+
+// StuckResource is a resource which has been deleted but is still present, because of its finalizers
+type StuckResource struct {
+	Kind              string
+	Name              string
+	Finalizers        []string
+	DeletionTimestamp time.Time
+	// Reason explains why the finalizers of the resource have not been removed
+	Reason string
+}
+
+// StuckResourcesReport lists the resources stuck in Terminating state
+type StuckResourcesReport struct {
+	// Fixed are the resources whose finalizers have been removed
+	Fixed []StuckResource
+	// NeedsAttention are the resources which need to be fixed by an administrator
+	NeedsAttention []StuckResource
+}
+
+// ForceDeleteStuckResources finds the resources matching the selector which are in Terminating state
+// since more than olderThan, and removes their finalizers when it is known to be safe.
+//
+// The only case considered as safe is a PVC having only the pvc-protection finalizer, not mounted by any pod
+// of the namespace and not owned by a pod (as for generic ephemeral volumes). All other stuck resources,
+// including the current namespace or project, are only reported, and never patched.
+func (c *Client) ForceDeleteStuckResources(selector string, olderThan time.Duration) (StuckResourcesReport, error) {
+	var report StuckResourcesReport
+	listOptions := metav1.ListOptions{LabelSelector: selector}
+
+	pvcs, err := c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).List(context.TODO(), listOptions)
+	if err != nil {
+		return report, fmt.Errorf("unable to get PVCs for selector %q: %w", selector, err)
+	}
+	var stuckPVCs []corev1.PersistentVolumeClaim
+	for _, pvc := range pvcs.Items {
+		if isStuck(&pvc.ObjectMeta, olderThan) {
+			stuckPVCs = append(stuckPVCs, pvc)
+		}
+	}
+	if len(stuckPVCs) > 0 {
+		// all the pods of the namespace are considered, as a PVC can be mounted by any pod
+		var pods *corev1.PodList
+		pods, err = c.KubeClient.CoreV1().Pods(c.Namespace).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return report, fmt.Errorf("unable to get pods: %w", err)
+		}
+		for _, pvc := range stuckPVCs {
+			stuck := newStuckResource(PersistentVolumeClaimKind, &pvc.ObjectMeta)
+			if len(pvc.Finalizers) != 1 || pvc.Finalizers[0] != pvcProtectionFinalizer {
+				stuck.Reason = "unknown finalizers"
+				report.NeedsAttention = append(report.NeedsAttention, stuck)
+				continue
+			}
+			if pod := podOwningPVC(&pvc); pod != "" {
+				stuck.Reason = fmt.Sprintf("owned by pod %q", pod)
+				report.NeedsAttention = append(report.NeedsAttention, stuck)
+				continue
+			}
+			if pod := podMountingPVC(pods.Items, pvc.Name); pod != "" {
+				stuck.Reason = fmt.Sprintf("mounted by pod %q", pod)
+				report.NeedsAttention = append(report.NeedsAttention, stuck)
+				continue
+			}
+			err = c.removePVCProtectionFinalizer(pvc.Name)
+			if err != nil {
+				return report, err
+			}
+			klog.V(2).Infof("removed finalizer %s from PVC %s", pvcProtectionFinalizer, pvc.Name)
+			report.Fixed = append(report.Fixed, stuck)
+		}
+	}
+
+	pods, err := c.KubeClient.CoreV1().Pods(c.Namespace).List(context.TODO(), listOptions)
+	if err != nil {
+		return report, fmt.Errorf("unable to get pods for selector %q: %w", selector, err)
+	}
+	for _, pod := range pods.Items {
+		report.addIfStuck("Pod", &pod.ObjectMeta, olderThan)
+	}
+
+	deployments, err := c.KubeClient.AppsV1().Deployments(c.Namespace).List(context.TODO(), listOptions)
+	if err != nil {
+		return report, fmt.Errorf("unable to get Deployments for selector %q: %w", selector, err)
+	}
+	for _, deployment := range deployments.Items {
+		report.addIfStuck(DeploymentKind, &deployment.ObjectMeta, olderThan)
+	}
+
+	services, err := c.KubeClient.CoreV1().Services(c.Namespace).List(context.TODO(), listOptions)
+	if err != nil {
+		return report, fmt.Errorf("unable to get services for selector %q: %w", selector, err)
+	}
+	for _, service := range services.Items {
+		report.addIfStuck("Service", &service.ObjectMeta, olderThan)
+	}
+
+	err = c.addNamespaceIfStuck(&report, olderThan)
+	return report, err
+}
+
+// addNamespaceIfStuck adds the current namespace to the resources needing attention if it is
+// in Terminating state since more than olderThan. Namespaces are never patched: their finalizers
+// are only removed once all their resources are deleted.
+func (c *Client) addNamespaceIfStuck(report *StuckResourcesReport, olderThan time.Duration) error {
+	// the namespace is not returned if the user is not allowed to get it
+	ns, err := c.GetNamespace(c.Namespace)
+	if err != nil {
+		return fmt.Errorf("unable to get namespace %q: %w", c.Namespace, err)
+	}
+	if ns == nil || ns.DeletionTimestamp == nil || time.Since(ns.DeletionTimestamp.Time) <= olderThan {
+		return nil
+	}
+
+	kind := "Namespace"
+	if isProject, err := c.IsProjectSupported(); err == nil && isProject {
+		kind = "Project"
+	}
+	stuck := newStuckResource(kind, &ns.ObjectMeta)
+	// a namespace is also blocked by the finalizers of its spec, handled by the namespace controller
+	for _, finalizer := range ns.Spec.Finalizers {
+		stuck.Finalizers = append(stuck.Finalizers, string(finalizer))
+	}
+	stuck.Reason = "namespaces are never fixed automatically"
+	report.NeedsAttention = append(report.NeedsAttention, stuck)
+	return nil
+}
+
+// removePVCProtectionFinalizer removes the finalizers of the PVC, using a JSON patch
+// which fails if the finalizers have been changed in the meantime
+func (c *Client) removePVCProtectionFinalizer(name string) error {
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "test", "path": "/metadata/finalizers", "value": []string{pvcProtectionFinalizer}},
+		{"op": "remove", "path": "/metadata/finalizers"},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Patch(context.TODO(), name, types.JSONPatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+	if err != nil {
+		return fmt.Errorf("unable to remove finalizer from PVC %q: %w", name, err)
+	}
+	return nil
+}
+
+// addIfStuck adds the resource to the resources needing attention if it is stuck
+func (o *StuckResourcesReport) addIfStuck(kind string, meta *metav1.ObjectMeta, olderThan time.Duration) {
+	if !isStuck(meta, olderThan) {
+		return
+	}
+	stuck := newStuckResource(kind, meta)
+	stuck.Reason = "only PVCs are fixed automatically"
+	o.NeedsAttention = append(o.NeedsAttention, stuck)
+}
+
+// isStuck returns true if the resource has been deleted since more than olderThan, and has finalizers
+func isStuck(meta *metav1.ObjectMeta, olderThan time.Duration) bool {
+	return meta.DeletionTimestamp != nil && len(meta.Finalizers) > 0 && time.Since(meta.DeletionTimestamp.Time) > olderThan
+}
+
+func newStuckResource(kind string, meta *metav1.ObjectMeta) StuckResource {
+	return StuckResource{
+		Kind:              kind,
+		Name:              meta.Name,
+		Finalizers:        meta.Finalizers,
+		DeletionTimestamp: meta.DeletionTimestamp.Time,
+	}
+}
+
+// podOwningPVC returns the name of the pod owning the PVC, or an empty string.
+// The PVCs of generic ephemeral volumes are owned by their pod, and deleted with it.
+func podOwningPVC(pvc *corev1.PersistentVolumeClaim) string {
+	for _, owner := range pvc.OwnerReferences {
+		if owner.Kind == "Pod" && owner.APIVersion == "v1" {
+			return owner.Name
+		}
+	}
+	return ""
+}
+
+// podMountingPVC returns the name of the first pod mounting the PVC, or an empty string
+func podMountingPVC(pods []corev1.Pod, pvcName string) string {
+	for _, pod := range pods {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvcName {
+				return pod.Name
+			}
+		}
+	}
+	return ""
+}
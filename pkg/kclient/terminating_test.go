@@ -0,0 +1,208 @@
+package kclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestForceDeleteStuckResources(t *testing.T) {
+	const selector = "app.kubernetes.io/managed-by=odo"
+	odoLabels := map[string]string{"app.kubernetes.io/managed-by": "odo"}
+	longAgo := metav1.NewTime(time.Now().Add(-time.Hour))
+	recently := metav1.NewTime(time.Now().Add(-time.Second))
+
+	meta := func(name string, deletion *metav1.Time, finalizers ...string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "ns",
+			Labels:            odoLabels,
+			DeletionTimestamp: deletion,
+			Finalizers:        finalizers,
+		}
+	}
+	pvc := func(name string, deletion *metav1.Time, finalizers ...string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{ObjectMeta: meta(name, deletion, finalizers...)}
+	}
+	podMounting := func(name string, pvcName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec: corev1.PodSpec{
+				Volumes: []corev1.Volume{{
+					Name: "vol",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvcName},
+					},
+				}},
+			},
+		}
+	}
+	podOwned := func(name string, podName string) *corev1.PersistentVolumeClaim {
+		claim := pvc(name, &longAgo, pvcProtectionFinalizer)
+		claim.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: podName}}
+		return claim
+	}
+	namespace := func(deletion *metav1.Time) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ns", DeletionTimestamp: deletion},
+			Spec:       corev1.NamespaceSpec{Finalizers: []corev1.FinalizerName{corev1.FinalizerKubernetes}},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		objects          []runtime.Object
+		projectSupported bool
+		wantFixed        []string
+		wantNeedsAttn    map[string]string
+		wantPatchedNames []string
+		// wantNamespaceKind is the kind reported for the stuck namespace, if any
+		wantNamespaceKind string
+	}{
+		{
+			name: "unmounted PVC with pvc-protection finalizer is fixed",
+			objects: []runtime.Object{
+				pvc("stuck", &longAgo, pvcProtectionFinalizer),
+			},
+			wantFixed:        []string{"stuck"},
+			wantPatchedNames: []string{"stuck"},
+		},
+		{
+			name: "mounted PVC is only reported",
+			objects: []runtime.Object{
+				pvc("stuck", &longAgo, pvcProtectionFinalizer),
+				// the pod is not labeled by odo, but mounts the PVC
+				podMounting("other-pod", "stuck"),
+			},
+			wantNeedsAttn: map[string]string{"stuck": `mounted by pod "other-pod"`},
+		},
+		{
+			name: "PVC owned by a pod is only reported",
+			objects: []runtime.Object{
+				// the PVC of a generic ephemeral volume, whose pod is already deleted
+				podOwned("pod-vol", "pod"),
+			},
+			wantNeedsAttn: map[string]string{"pod-vol": `owned by pod "pod"`},
+		},
+		{
+			name: "PVC with unknown finalizers is only reported",
+			objects: []runtime.Object{
+				pvc("stuck", &longAgo, pvcProtectionFinalizer, "example.com/backup"),
+			},
+			wantNeedsAttn: map[string]string{"stuck": "unknown finalizers"},
+		},
+		{
+			name: "recently deleted and not deleted PVCs are ignored",
+			objects: []runtime.Object{
+				pvc("recent", &recently, pvcProtectionFinalizer),
+				pvc("alive", nil, pvcProtectionFinalizer),
+			},
+		},
+		{
+			name: "other stuck resources are only reported",
+			objects: []runtime.Object{
+				&appsv1.Deployment{ObjectMeta: meta("deploy", &longAgo, "example.com/finalizer")},
+				&corev1.Service{ObjectMeta: meta("svc", &longAgo, "example.com/finalizer")},
+				&corev1.Pod{ObjectMeta: meta("pod", &longAgo, "example.com/finalizer")},
+			},
+			wantNeedsAttn: map[string]string{
+				"deploy": "only PVCs are fixed automatically",
+				"svc":    "only PVCs are fixed automatically",
+				"pod":    "only PVCs are fixed automatically",
+			},
+		},
+		{
+			name:              "stuck namespace is only reported",
+			objects:           []runtime.Object{namespace(&longAgo)},
+			wantNeedsAttn:     map[string]string{"ns": "namespaces are never fixed automatically"},
+			wantNamespaceKind: "Namespace",
+		},
+		{
+			name:              "stuck project is only reported",
+			objects:           []runtime.Object{namespace(&longAgo)},
+			projectSupported:  true,
+			wantNeedsAttn:     map[string]string{"ns": "namespaces are never fixed automatically"},
+			wantNamespaceKind: "Project",
+		},
+		{
+			name:    "recently deleted and not deleted namespaces are ignored",
+			objects: []runtime.Object{namespace(&recently)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient, fakeClientSet := FakeNew()
+			fakeClient.Namespace = "ns"
+			if tt.projectSupported {
+				fakeClientSet.Kubernetes.Resources = []*metav1.APIResourceList{{
+					GroupVersion: "project.openshift.io/v1",
+					APIResources: []metav1.APIResource{{Name: "projects"}},
+				}}
+			}
+			for _, obj := range tt.objects {
+				if err := fakeClientSet.Kubernetes.Tracker().Add(obj); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var patched []string
+			fakeClientSet.Kubernetes.PrependReactor("patch", "*", func(action ktesting.Action) (bool, runtime.Object, error) {
+				patched = append(patched, action.GetResource().Resource+"/"+action.(ktesting.PatchAction).GetName())
+				return false, nil, nil
+			})
+
+			report, err := fakeClient.ForceDeleteStuckResources(selector, time.Minute)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var gotFixed []string
+			for _, r := range report.Fixed {
+				gotFixed = append(gotFixed, r.Name)
+			}
+			if diff := cmp.Diff(tt.wantFixed, gotFixed); diff != "" {
+				t.Errorf("Fixed mismatch (-want +got):\n%s", diff)
+			}
+			gotNeedsAttn := map[string]string{}
+			var gotNamespaceKind string
+			for _, r := range report.NeedsAttention {
+				gotNeedsAttn[r.Name] = r.Reason
+				if r.Name == "ns" {
+					gotNamespaceKind = r.Kind
+					if diff := cmp.Diff([]string{"kubernetes"}, r.Finalizers); diff != "" {
+						t.Errorf("namespace finalizers mismatch (-want +got):\n%s", diff)
+					}
+				}
+			}
+			if gotNamespaceKind != tt.wantNamespaceKind {
+				t.Errorf("expected namespace kind %q, got %q", tt.wantNamespaceKind, gotNamespaceKind)
+			}
+			if diff := cmp.Diff(tt.wantNeedsAttn, gotNeedsAttn, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("NeedsAttention mismatch (-want +got):\n%s", diff)
+			}
+
+			var wantPatched []string
+			for _, name := range tt.wantPatchedNames {
+				wantPatched = append(wantPatched, "persistentvolumeclaims/"+name)
+			}
+			if diff := cmp.Diff(wantPatched, patched); diff != "" {
+				t.Errorf("patched resources mismatch (-want +got):\n%s", diff)
+			}
+			for _, name := range tt.wantPatchedNames {
+				got, err := fakeClient.GetPVCFromName(name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(got.Finalizers) != 0 {
+					t.Errorf("expected finalizers of PVC %q to be removed, got %v", name, got.Finalizers)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package kclient
+
+import "time"
+
+// Timeouts are the maximal durations the client waits for resources to reach the expected state.
+// The default values are used for the timeouts not set
+type Timeouts struct {
+	// ResourceDeletion is the maximal duration to wait for a resource (namespace, project, custom resource, ...) to be deleted
+	ResourceDeletion time.Duration
+	// ServiceAccount is the maximal duration to wait for a service account to be created in a new namespace
+	ServiceAccount time.Duration
+	// Secret is the maximal duration to wait for a secret to be created
+	Secret time.Duration
+	// Retry is the maximal duration to retry an operation failing with a transient error
+	Retry time.Duration
+}
+
+// DefaultTimeouts returns the timeouts used when none are configured
+func DefaultTimeouts() Timeouts {
+	return Timeouts{
+		ResourceDeletion: 3 * time.Minute,
+		ServiceAccount:   1 * time.Minute,
+		Secret:           3 * time.Minute,
+		Retry:            30 * time.Second,
+	}
+}
+
+// timeouts returns the timeouts of the client, using the default values for the ones not set
+func (c *Client) timeouts() Timeouts {
+	timeouts := c.Timeouts
+	defaults := DefaultTimeouts()
+	if timeouts.ResourceDeletion <= 0 {
+		timeouts.ResourceDeletion = defaults.ResourceDeletion
+	}
+	if timeouts.ServiceAccount <= 0 {
+		timeouts.ServiceAccount = defaults.ServiceAccount
+	}
+	if timeouts.Secret <= 0 {
+		timeouts.Secret = defaults.Secret
+	}
+	if timeouts.Retry <= 0 {
+		timeouts.Retry = defaults.Retry
+	}
+	return timeouts
+}
@@ -0,0 +1,108 @@
+package kclient
+
+import (
+	"testing"
+	"time"
+
+	projectv1 "github.com/openshift/api/project/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestTimeouts(t *testing.T) {
+	const timeout = 10 * time.Millisecond
+	tests := []struct {
+		name string
+		// resource is the resource watched by the helper
+		resource string
+		timeouts Timeouts
+		// setup creates the resources needed by the helper
+		setup func(client *Client, fakeClientSet *FakeClientset)
+		// wait calls the helper, which is expected to time out
+		wait func(client *Client) error
+	}{
+		{
+			name:     "namespace deletion",
+			resource: "namespaces",
+			timeouts: Timeouts{ResourceDeletion: timeout},
+			setup: func(client *Client, _ *FakeClientset) {
+				_, _ = client.CreateNamespace("my-namespace")
+			},
+			wait: func(client *Client) error {
+				return client.DeleteNamespace("my-namespace", true)
+			},
+		},
+		{
+			name:     "project deletion",
+			resource: "projects",
+			timeouts: Timeouts{ResourceDeletion: timeout},
+			setup: func(_ *Client, fakeClientSet *FakeClientset) {
+				_ = fakeClientSet.ProjClientset.Tracker().Add(&projectv1.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-project"},
+				})
+			},
+			wait: func(client *Client) error {
+				return client.DeleteProject("my-project", true)
+			},
+		},
+		{
+			name:     "service account",
+			resource: "serviceaccounts",
+			timeouts: Timeouts{ServiceAccount: timeout},
+			wait: func(client *Client) error {
+				return client.WaitForServiceAccountInNamespace("my-namespace", "default")
+			},
+		},
+		{
+			name:     "secret",
+			resource: "secrets",
+			timeouts: Timeouts{Secret: timeout},
+			wait: func(client *Client) error {
+				_, err := client.WaitAndGetSecret("my-secret", "my-namespace")
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, fakeClientSet := FakeNew()
+			client.Timeouts = tt.timeouts
+			// the watch never sends any event
+			fkWatch := watch.NewFake()
+			defer fkWatch.Stop()
+			reactor := func(action ktesting.Action) (bool, watch.Interface, error) {
+				return true, fkWatch, nil
+			}
+			fakeClientSet.Kubernetes.PrependWatchReactor(tt.resource, reactor)
+			fakeClientSet.ProjClientset.PrependWatchReactor(tt.resource, reactor)
+			if tt.setup != nil {
+				tt.setup(client, fakeClientSet)
+			}
+
+			done := make(chan error)
+			go func() {
+				done <- tt.wait(client)
+			}()
+			select {
+			case err := <-done:
+				if err == nil {
+					t.Errorf("expected a timeout error")
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("the configured timeout has not been used")
+			}
+		})
+	}
+}
+
+func TestTimeouts_defaults(t *testing.T) {
+	client := &Client{
+		Timeouts: Timeouts{Secret: time.Second},
+	}
+	want := DefaultTimeouts()
+	want.Secret = time.Second
+	if got := client.timeouts(); got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	oauthv1client "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/klog"
 )
 
@@ -34,18 +35,17 @@ func (c *Client) RunLogout(stdout io.Writer) error {
 		klog.V(1).Infof("%v", e)
 	}
 
-	rawConfig, err := c.KubeConfig.RawConfig()
-	if err != nil {
-		klog.V(1).Infof("%v : unable to switch to  project", err)
-	}
-
 	// deleting token for the current server from local config
-	for key, value := range rawConfig.AuthInfos {
-		if key == rawConfig.Contexts[rawConfig.CurrentContext].AuthInfo {
-			value.Token = ""
+	err = modifyKubeConfig(clientcmd.NewDefaultClientConfigLoadingRules(), func(config *clientcmdapi.Config) error {
+		currentContext, ok := config.Contexts[config.CurrentContext]
+		if !ok {
+			return fmt.Errorf("current context %q not found", config.CurrentContext)
 		}
-	}
-	err = clientcmd.ModifyConfig(clientcmd.NewDefaultClientConfigLoadingRules(), rawConfig, true)
+		if authInfo, ok := config.AuthInfos[currentContext.AuthInfo]; ok {
+			authInfo.Token = ""
+		}
+		return nil
+	})
 	if err != nil {
 		klog.V(1).Infof("%v : unable to write config to config file", err)
 	}
@@ -34,8 +34,12 @@ func (c *Client) DeletePVC(pvcName string) error {
 
 // ListPVCs returns the PVCs based on the given selector
 func (c *Client) ListPVCs(selector string) ([]corev1.PersistentVolumeClaim, error) {
-	pvcList, err := c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).List(context.TODO(), metav1.ListOptions{
-		LabelSelector: selector,
+	var pvcList *corev1.PersistentVolumeClaimList
+	err := c.retryTransient(func() (err error) {
+		pvcList, err = c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: selector,
+		})
+		return err
 	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to get PVCs for selector: %v: %w", selector, err)
@@ -61,7 +65,12 @@ func (c *Client) ListPVCNames(selector string) ([]string, error) {
 
 // GetPVCFromName returns the PVC of the given name
 func (c *Client) GetPVCFromName(pvcName string) (*corev1.PersistentVolumeClaim, error) {
-	return c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Get(context.TODO(), pvcName, metav1.GetOptions{})
+	var pvc *corev1.PersistentVolumeClaim
+	err := c.retryTransient(func() (err error) {
+		pvc, err = c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Get(context.TODO(), pvcName, metav1.GetOptions{})
+		return err
+	})
+	return pvc, err
 }
 
 // UpdatePVCLabels updates the given PVC with the given labels
@@ -2,7 +2,9 @@ package labels
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -215,6 +217,31 @@ func GetSelector(componentName string, applicationName string, mode string, isPa
 	return labels.String()
 }
 
+// GetPodSelector returns a selector string used for selection of the pod of the given component running in Dev mode.
+// The selector contains the full set of component and application labels, so that pods of other components
+// or applications sharing some of the labels are not selected
+func GetPodSelector(componentName string, applicationName string) string {
+	return GetSelector(componentName, applicationName, ComponentDevMode, true)
+}
+
+// CheckPodLabels returns an error if the labels of a pod do not contain all the labels
+// identifying the pod of the given component running in Dev mode
+func CheckPodLabels(podLabels map[string]string, componentName string, applicationName string) error {
+	expected := getLabels(componentName, applicationName, ComponentDevMode, false, true)
+	var mismatches []string
+	for key, value := range expected {
+		if podLabels[key] != value {
+			mismatches = append(mismatches, fmt.Sprintf("%s=%q (expected %q)", key, podLabels[key], value))
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	sort.Strings(mismatches)
+	return fmt.Errorf("pod does not belong to component %q of application %q, mismatching labels: %s",
+		componentName, applicationName, strings.Join(mismatches, ", "))
+}
+
 func GetNameSelector(componentName string) string {
 	labels := k8slabels.Set{
 		kubernetesInstanceLabel: componentName,
@@ -265,3 +265,39 @@ func Test_sanitizeLabelValue(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckPodLabels(t *testing.T) {
+	tests := []struct {
+		name      string
+		podLabels map[string]string
+		wantErr   bool
+	}{
+		{
+			name:      "pod of the component",
+			podLabels: GetLabels("nodejs", "app", "nodejs", ComponentDevMode, true),
+		},
+		{
+			name:      "pod of the component with the same name in another application",
+			podLabels: GetLabels("nodejs", "other", "nodejs", ComponentDevMode, true),
+			wantErr:   true,
+		},
+		{
+			name:      "pod of the component deployed with odo deploy",
+			podLabels: GetLabels("nodejs", "app", "nodejs", ComponentDeployMode, false),
+			wantErr:   true,
+		},
+		{
+			name:      "pod with only the component label",
+			podLabels: map[string]string{componentLabel: "nodejs"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPodLabels(tt.podLabels, "nodejs", "app")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckPodLabels() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 )
 
 // NoCommandFoundError is returned when no command of the specified kind is found in devfile
@@ -28,6 +30,10 @@ func (e NoCommandFoundError) Error() string {
 	return fmt.Sprintf("no %s command with name %q found in Devfile", e.kind, e.name)
 }
 
+func (e NoCommandFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
 // NoDefaultCommandFoundError is returned when several commands of the specified kind exist
 // but no one is the default one
 type NoDefaultCommandFoundError struct {
@@ -43,6 +49,10 @@ func (e NoDefaultCommandFoundError) Error() string {
 	return fmt.Sprintf("no default %s command found in devfile", e.kind)
 }
 
+func (e NoDefaultCommandFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
 // MoreThanOneDefaultCommandFoundError is returned when several default commands of the specified kind exist
 type MoreThanOneDefaultCommandFoundError struct {
 	kind v1alpha2.CommandGroupKind
@@ -57,6 +67,10 @@ func (e MoreThanOneDefaultCommandFoundError) Error() string {
 	return fmt.Sprintf("more than one default %s command found in devfile, this should not happen", e.kind)
 }
 
+func (e MoreThanOneDefaultCommandFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
+
 // ComponentNotExistError is returned when a component referenced in a command or component does not exist
 type ComponentNotExistError struct {
 	name string
@@ -72,6 +86,10 @@ func (e ComponentNotExistError) Error() string {
 	return fmt.Sprintf("component %q does not exists", e.name)
 }
 
+func (e ComponentNotExistError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
 type ComponentsWithSameNameError struct {
 	name string
 }
@@ -86,6 +104,10 @@ func (e ComponentsWithSameNameError) Error() string {
 	return fmt.Sprintf("more than one component with the same name %q, should not happen", e.name)
 }
 
+func (e ComponentsWithSameNameError) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
+
 // ComponentTypeNotFoundError is returned when no component with the specified type has been found in Devfile
 type ComponentTypeNotFoundError struct {
 	componentType v1alpha2.ComponentType
@@ -101,6 +123,10 @@ func (e ComponentTypeNotFoundError) Error() string {
 	return fmt.Sprintf("no component with type %q found in Devfile", e.componentType)
 }
 
+func (e ComponentTypeNotFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
 // NoCommandForGroup indicates an error when no command was found for the given Group
 type NoCommandForGroup struct {
 	Group v1alpha2.CommandGroupKind
@@ -109,3 +135,7 @@ type NoCommandForGroup struct {
 func (n NoCommandForGroup) Error() string {
 	return fmt.Sprintf("the command group of kind \"%v\" is not found in the devfile", n.Group)
 }
+
+func (n NoCommandForGroup) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
@@ -1,6 +1,8 @@
 package libdevfile
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -20,6 +22,13 @@ import (
 )
 
 func TestGetReferencedLocalFiles(t *testing.T) {
+	// parsing the devfiles with a parent writes a copy of the parent devfiles next to the child devfiles
+	t.Cleanup(func() {
+		copies, _ := filepath.Glob(filepath.Join("testdata", "parent-devfile*.yaml"))
+		for _, c := range copies {
+			_ = os.Remove(c)
+		}
+	})
 
 	imageComponentNoDockerfile := generator.GetImageComponent(generator.ImageComponentParams{
 		Name: "image-component",
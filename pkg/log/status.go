@@ -50,10 +50,11 @@ var colorCounter = 0
 // Status is used to track ongoing status in a CLI, with a nice loading spinner
 // when attached to a terminal
 type Status struct {
-	spinner       *fidget.Spinner
-	status        string
-	warningStatus string
-	writer        io.Writer
+	spinner        *fidget.Spinner
+	status         string
+	warningStatus  string
+	progressStatus string
+	writer         io.Writer
 }
 
 // NewStatus creates a new default Status
@@ -85,10 +86,30 @@ func (s *Status) WarningStatus(status string) {
 	s.updateStatus()
 }
 
+// ProgressStatus appends a progress indicator (e.g. a percentage) to the spinner and then updates the current status.
+// Unlike the status itself, the progress indicator is not displayed once the status ends
+// It can be called from a goroutine other than the one starting and ending the status.
+func (s *Status) ProgressStatus(progress string) {
+	mu.Lock()
+	defer mu.Unlock()
+	s.progressStatus = progress
+	s.updateStatusLocked()
+}
+
 // Updates the status and makes sure that if the previous status was longer, it
 // "clears" the rest of the message.
 func (s *Status) updateStatus() {
 	mu.Lock()
+	defer mu.Unlock()
+	s.updateStatusLocked()
+}
+
+// updateStatusLocked updates the status, mu must be held
+func (s *Status) updateStatusLocked() {
+	status := s.status
+	if s.progressStatus != "" {
+		status = fmt.Sprintf("%s (%s)", s.status, s.progressStatus)
+	}
 	if s.warningStatus != "" {
 		yellow := color.New(color.FgYellow).SprintFunc()
 
@@ -96,16 +117,15 @@ func (s *Status) updateStatus() {
 		warningSubstring := fmt.Sprintf(" [%s %s]", yellow(getWarningString()), yellow(s.warningStatus))
 
 		// Combine suffix and spacing, then resize them
-		newSuffix := fmt.Sprintf(suffixSpacing+"%s", s.status)
+		newSuffix := fmt.Sprintf(suffixSpacing+"%s", status)
 		newSuffix = truncateSuffixIfNeeded(newSuffix, s.writer, len(warningSubstring))
 
 		// Combine the warning and non-warning text (since we don't want to truncate the warning text)
 		s.spinner.SetSuffix(fmt.Sprintf("%s%s", newSuffix, warningSubstring))
 	} else {
-		newSuffix := fmt.Sprintf(suffixSpacing+"%s", s.status)
+		newSuffix := fmt.Sprintf(suffixSpacing+"%s", status)
 		s.spinner.SetSuffix(truncateSuffixIfNeeded(newSuffix, s.writer, 0))
 	}
-	mu.Unlock()
 }
 
 // Start starts a new phase of the status, if attached to a terminal
@@ -115,7 +135,9 @@ func (s *Status) Start(status string, debug bool) {
 
 	// set new status
 	isTerm := IsTerminal(s.writer)
+	mu.Lock()
 	s.status = status
+	mu.Unlock()
 
 	// If we are in debug mode, don't spin!
 	// In under no circumstances do we output if we're using -o json.. to
@@ -219,7 +241,10 @@ func (s *Status) End(success bool) {
 		}
 	}
 
+	mu.Lock()
 	s.status = ""
+	s.progressStatus = ""
+	mu.Unlock()
 }
 
 // EndWithStatus is similar to End, but lets the user specify a custom message/status while ending
@@ -227,7 +252,9 @@ func (s *Status) EndWithStatus(status string, success bool) {
 	if status == "" {
 		return
 	}
+	mu.Lock()
 	s.status = status
+	mu.Unlock()
 	s.End(success)
 }
 
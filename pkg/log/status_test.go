@@ -1,6 +1,8 @@
 package log
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -62,3 +64,19 @@ Nam sed risus blandit,
 		})
 	}
 }
+
+func TestStatus_ProgressStatus_concurrent(t *testing.T) {
+	var out bytes.Buffer
+	s := NewStatus(&out)
+	s.Start("Syncing files into the container", false)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i <= 100; i += 10 {
+			s.ProgressStatus(fmt.Sprintf("%d%%", i))
+		}
+	}()
+	s.End(true)
+	<-done
+}
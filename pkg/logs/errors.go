@@ -3,6 +3,7 @@ package logs
 import (
 	"fmt"
 
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 )
 
@@ -13,3 +14,7 @@ type InvalidModeError struct {
 func (e InvalidModeError) Error() string {
 	return fmt.Sprintf("invalid mode %q; valid modes are %q, %q, and %q", e.mode, odolabels.ComponentDevMode, odolabels.ComponentDeployMode, odolabels.ComponentAnyMode)
 }
+
+func (e InvalidModeError) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
@@ -0,0 +1,214 @@
+package machineoutput
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"k8s.io/klog"
+)
+
+// DevEventType is the type of an event reported during a Dev session
+type DevEventType string
+
+const (
+	// DevEventStepStarted is reported when a step (sync of files, build, run, ...) starts
+	DevEventStepStarted DevEventType = "stepStarted"
+	// DevEventStepSucceeded is reported when a step completes successfully
+	DevEventStepSucceeded DevEventType = "stepSucceeded"
+	// DevEventStepFailed is reported when a step fails; the error is part of the event
+	DevEventStepFailed DevEventType = "stepFailed"
+	// DevEventFilesSynced is reported when files are synced to the component; the synced files are part of the event
+	DevEventFilesSynced DevEventType = "filesSynced"
+	// DevEventLogText is reported for each line of text written by odo or by the commands of the component
+	DevEventLogText DevEventType = "logText"
+	// DevEventSyncPaused is reported when the sync of files is paused; the number of pending changes is part of the event
+	DevEventSyncPaused DevEventType = "syncPaused"
+	// DevEventSyncResumed is reported when the sync of files is resumed; the number of pending changes, synced at once, is part of the event
+	DevEventSyncResumed DevEventType = "syncResumed"
+)
+
+// DevEvent is a single line of the event stream of a Dev session.
+// The fields of this structure are part of the stable schema consumed by integrations (IDE plugins, etc);
+// fields can be added, but not removed or renamed.
+type DevEvent struct {
+	Type      DevEventType `json:"type"`
+	Timestamp string       `json:"timestamp"`
+	Component string       `json:"component"`
+	Message   string       `json:"message,omitempty"`
+	Error     string       `json:"error,omitempty"`
+	// Stream is either stdout or stderr, for logText events
+	Stream string `json:"stream,omitempty"`
+	// Files are the changed files, for filesSynced events
+	Files []string `json:"files,omitempty"`
+	// DeletedFiles are the deleted files, for filesSynced events
+	DeletedFiles []string `json:"deletedFiles,omitempty"`
+	// PendingChanges is the number of changes not synced yet, for syncPaused and syncResumed events
+	PendingChanges int `json:"pendingChanges,omitempty"`
+}
+
+// EventReporter reports the progress of a Dev session
+type EventReporter interface {
+	StepStarted(message string)
+	StepSucceeded(message string)
+	StepFailed(message string, err error)
+	FilesSynced(changedFiles, deletedFiles []string)
+	SyncPaused(pendingChanges int)
+	SyncResumed(pendingChanges int)
+	// LogWriter returns a writer for which each non-blank line written is reported as a logText event on the stream (stdout or stderr).
+	// Close must be called to report the last line, if not terminated by a newline character.
+	LogWriter(stream string) io.WriteCloser
+}
+
+// ReportStep runs step, reporting to reporter the start of the step, then its success or its failure.
+// The step is run without reporting any event if reporter is nil
+func ReportStep(reporter EventReporter, message string, step func() error) error {
+	if reporter == nil {
+		return step()
+	}
+	reporter.StepStarted(message)
+	err := step()
+	if err != nil {
+		reporter.StepFailed(message, err)
+		return err
+	}
+	reporter.StepSucceeded(message)
+	return nil
+}
+
+// NoOpEventReporter ignores all the events
+type NoOpEventReporter struct{}
+
+var _ EventReporter = NoOpEventReporter{}
+
+func (NoOpEventReporter) StepStarted(string)              {}
+func (NoOpEventReporter) StepSucceeded(string)            {}
+func (NoOpEventReporter) StepFailed(string, error)        {}
+func (NoOpEventReporter) FilesSynced(_, _ []string)       {}
+func (NoOpEventReporter) SyncPaused(int)                  {}
+func (NoOpEventReporter) SyncResumed(int)                 {}
+func (NoOpEventReporter) LogWriter(string) io.WriteCloser { return nopWriteCloser{io.Discard} }
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// JSONEventReporter writes the events to a writer as newline-delimited JSON (one DevEvent per line)
+type JSONEventReporter struct {
+	component string
+
+	mu  sync.Mutex
+	out io.Writer
+	// now returns the time of the events; it can be overridden for tests
+	now func() time.Time
+}
+
+var _ EventReporter = (*JSONEventReporter)(nil)
+
+// NewJSONEventReporter creates a JSONEventReporter writing the events of the component to out
+func NewJSONEventReporter(out io.Writer, component string) *JSONEventReporter {
+	return &JSONEventReporter{
+		component: component,
+		out:       out,
+		now:       time.Now,
+	}
+}
+
+// StepStarted reports a stepStarted event
+func (o *JSONEventReporter) StepStarted(message string) {
+	o.report(DevEvent{Type: DevEventStepStarted, Message: message})
+}
+
+// StepSucceeded reports a stepSucceeded event
+func (o *JSONEventReporter) StepSucceeded(message string) {
+	o.report(DevEvent{Type: DevEventStepSucceeded, Message: message})
+}
+
+// StepFailed reports a stepFailed event
+func (o *JSONEventReporter) StepFailed(message string, err error) {
+	event := DevEvent{Type: DevEventStepFailed, Message: message}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	o.report(event)
+}
+
+// FilesSynced reports a filesSynced event
+func (o *JSONEventReporter) FilesSynced(changedFiles, deletedFiles []string) {
+	o.report(DevEvent{Type: DevEventFilesSynced, Files: changedFiles, DeletedFiles: deletedFiles})
+}
+
+// SyncPaused reports a syncPaused event
+func (o *JSONEventReporter) SyncPaused(pendingChanges int) {
+	o.report(DevEvent{Type: DevEventSyncPaused, PendingChanges: pendingChanges})
+}
+
+// SyncResumed reports a syncResumed event
+func (o *JSONEventReporter) SyncResumed(pendingChanges int) {
+	o.report(DevEvent{Type: DevEventSyncResumed, PendingChanges: pendingChanges})
+}
+
+// LogWriter returns a writer reporting each line as a logText event
+func (o *JSONEventReporter) LogWriter(stream string) io.WriteCloser {
+	return &logTextWriter{reporter: o, stream: stream}
+}
+
+func (o *JSONEventReporter) report(event DevEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	event.Timestamp = FormatTime(o.now())
+	event.Component = o.component
+	data, err := json.Marshal(event)
+	if err != nil {
+		klog.V(4).Infof("unable to marshal event: %v", err)
+		return
+	}
+	_, err = o.out.Write(append(data, '\n'))
+	if err != nil {
+		klog.V(4).Infof("unable to write event: %v", err)
+	}
+}
+
+// logTextWriter reports each line written as a logText event, so that the raw text written by odo
+// or by the commands of the component does not break the event stream
+type logTextWriter struct {
+	reporter *JSONEventReporter
+	stream   string
+
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (o *logTextWriter) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.buf.Write(p)
+	for {
+		i := bytes.IndexByte(o.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		line := string(bytes.TrimRight(o.buf.Next(i+1), "\r\n"))
+		if line == "" {
+			// blank lines are only used for the layout of the human-readable output
+			continue
+		}
+		o.reporter.report(DevEvent{Type: DevEventLogText, Message: line, Stream: o.stream})
+	}
+	return len(p), nil
+}
+
+// Close reports the last line, if not terminated by a newline character
+func (o *logTextWriter) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.buf.Len() > 0 {
+		o.reporter.report(DevEvent{Type: DevEventLogText, Message: o.buf.String(), Stream: o.stream})
+		o.buf.Reset()
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package machineoutput
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJSONEventReporter(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		report func(reporter EventReporter)
+	}{
+		{
+			name:   "successful sync of files",
+			golden: "sync_success.ndjson",
+			report: func(reporter EventReporter) {
+				reporter.StepStarted("Pushing changes")
+				reporter.FilesSynced([]string{"/project/main.go", "/project/go.mod"}, []string{"/project/old.go"})
+				reporter.StepSucceeded("Pushing changes")
+			},
+		},
+		{
+			name:   "failed sync of files",
+			golden: "sync_failure.ndjson",
+			report: func(reporter EventReporter) {
+				reporter.StepStarted("Pushing changes")
+				reporter.StepFailed("Pushing changes", errors.New("unable to access the cluster"))
+			},
+		},
+		{
+			name:   "steps of a push",
+			golden: "push_steps.ndjson",
+			report: func(reporter EventReporter) {
+				_ = ReportStep(reporter, "Pushing changes", func() error {
+					_ = ReportStep(reporter, "Building the application", func() error { return nil })
+					return ReportStep(reporter, "Running the application", func() error {
+						return errors.New("command exited with status 1")
+					})
+				})
+			},
+		},
+		{
+			name:   "sync of files paused and resumed",
+			golden: "sync_paused.ndjson",
+			report: func(reporter EventReporter) {
+				reporter.SyncPaused(0)
+				reporter.SyncResumed(12)
+			},
+		},
+		{
+			name:   "log lines are wrapped into events",
+			golden: "log_text.ndjson",
+			report: func(reporter EventReporter) {
+				stdout := reporter.LogWriter("stdout")
+				stderr := reporter.LogWriter("stderr")
+				fmt.Fprintf(stdout, "Pushing files...\n\n")
+				fmt.Fprint(stdout, "Building ")
+				fmt.Fprint(stderr, "npm WARN deprecated\r\n")
+				fmt.Fprint(stdout, "your application\nRunning")
+				_ = stdout.Close()
+				_ = stderr.Close()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			reporter := NewJSONEventReporter(&out, "my-component")
+			reporter.now = func() time.Time { return time.Unix(1700000000, 123456000) }
+
+			tt.report(reporter)
+
+			want, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(want), out.String()); diff != "" {
+				t.Errorf("event stream mismatch with %s (-want +got):\n%s", tt.golden, diff)
+			}
+		})
+	}
+}
+
+func TestNoOpEventReporter(t *testing.T) {
+	reporter := NoOpEventReporter{}
+	writer := reporter.LogWriter("stdout")
+	if _, err := fmt.Fprintln(writer, "some text"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestReportStep_nilReporter(t *testing.T) {
+	wantErr := errors.New("failure")
+	if err := ReportStep(nil, "Building the application", func() error { return wantErr }); !errors.Is(err, wantErr) {
+		t.Errorf("expected the error of the step, got %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 )
 
 // ListKind is the kind used for all lists in the machine readable output
@@ -37,8 +38,6 @@ func OutputSuccessUnindented(machineOutput interface{}) {
 
 // OutputSuccess outputs a "successful" machine-readable output format in json
 func OutputSuccess(stdout, stderr io.Writer, machineOutput interface{}) {
-	printableOutput, err := marshalJSONIndented(machineOutput)
-
 	if stdout == nil {
 		stdout = log.GetStdout()
 	}
@@ -47,10 +46,9 @@ func OutputSuccess(stdout, stderr io.Writer, machineOutput interface{}) {
 	}
 
 	// If we error out... there's no way to output it (since we disable logging when using -o json)
-	if err != nil {
+	// Empty lists are rendered as [] instead of null
+	if err := ui.RenderJSON(stdout, machineOutput); err != nil {
 		fmt.Fprintf(stderr, "Unable to unmarshal JSON: %s\n", err.Error())
-	} else {
-		fmt.Fprintf(stdout, "%s\n", string(printableOutput))
 	}
 }
 
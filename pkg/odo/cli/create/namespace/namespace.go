@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
+	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
@@ -77,7 +78,19 @@ func (nco *NamespaceCreateOptions) Complete(ctx context.Context, cmdline cmdline
 
 // Validate validates the parameters of the NamespaceCreateOptions
 func (nco *NamespaceCreateOptions) Validate(ctx context.Context) error {
-	return dfutil.ValidateK8sResourceName("namespace name", nco.namespaceName)
+	err := dfutil.ValidateK8sResourceName("namespace name", nco.namespaceName)
+	if err != nil {
+		return err
+	}
+	// the names reserved by OpenShift can be used for namespaces on other clusters
+	isProjectSupported, err := nco.clientset.KubernetesClient.IsProjectSupported()
+	if err != nil {
+		return fmt.Errorf("unable to detect project support: %w", err)
+	}
+	if isProjectSupported {
+		return kclient.ValidateProjectName(nco.namespaceName)
+	}
+	return kclient.ValidateNamespaceName(nco.namespaceName)
 }
 
 // Run runs the namespace create command
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -14,6 +15,7 @@ import (
 	apiserver_impl "github.com/redhat-developer/odo/pkg/apiserver-impl"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	dfutil "github.com/devfile/library/v2/pkg/util"
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
@@ -26,6 +28,7 @@ import (
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
@@ -76,6 +79,7 @@ type DevOptions struct {
 	apiServerPortFlag    int
 	syncGitDirFlag       bool
 	logsFlag             bool
+	checkIgnoresFlag     bool
 }
 
 var _ genericclioptions.Runnable = (*DevOptions)(nil)
@@ -217,6 +221,18 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		panic(fmt.Errorf("platform %s is not implemented", platform))
 	}
 
+	var eventReporter machineoutput.EventReporter
+	if log.IsJSON() {
+		// the text written by odo and by the commands of the component is reported as events,
+		// so that the output only contains JSON events
+		jsonReporter := machineoutput.NewJSONEventReporter(o.out, componentName)
+		stdout, stderr := jsonReporter.LogWriter("stdout"), jsonReporter.LogWriter("stderr")
+		defer stdout.Close()
+		defer stderr.Close()
+		o.out, o.errOut = stdout, stderr
+		eventReporter = jsonReporter
+	}
+
 	// Output what the command is doing / information
 	log.Title("Developing using the \""+componentName+"\" Devfile", dest)
 	if platform == commonflags.PlatformCluster {
@@ -249,6 +265,10 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		o.ignorePaths = removeGitDir(o.ignorePaths)
 	}
 
+	if o.checkIgnoresFlag {
+		warnAboutUnusedIgnorePatterns(path, component.GetComponentTypeFromDevfileMetadata(devFileObj.Data.GetMetadata()))
+	}
+
 	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devFileObj.Data.GetMetadata()))
 	scontext.SetLanguage(ctx, devFileObj.Data.GetMetadata().Language)
 	scontext.SetProjectType(ctx, devFileObj.Data.GetMetadata().ProjectType)
@@ -298,7 +318,8 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 
 	o.clientset.InformerClient.AppendInfo(log.Sbold("Keyboard Commands:") + "\n" +
 		"[Ctrl+c] - Exit and delete resources from " + deployingTo + "\n" +
-		"     [p] - Manually apply local changes to the application on " + deployingTo + "\n")
+		"     [p] - Manually apply local changes to the application on " + deployingTo + "\n" +
+		"     [s] - Pause or resume the sync of local changes\n")
 
 	return o.clientset.DevClient.Start(
 		o.ctx,
@@ -316,12 +337,41 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 			CustomForwardedPorts: o.forwardedPorts,
 			CustomAddress:        o.addressFlag,
 			PushWatcher:          apiServer.PushWatcher,
+			EventReporter:        eventReporter,
 			Out:                  o.out,
 			ErrOut:               o.errOut,
 		},
 	)
 }
 
+// warnAboutUnusedIgnorePatterns warns about the patterns of the .odoignore file of the directory matching no file,
+// as they are often typos. The default patterns for the component type are not checked,
+// as they are for files which do not necessarily exist yet
+func warnAboutUnusedIgnorePatterns(directory string, componentType string) {
+	rules, err := util.ParseIgnoreFile(filepath.Join(directory, util.DotOdoIgnoreFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			klog.V(4).Infof("unable to read the %s file: %v", util.DotOdoIgnoreFile, err)
+		}
+		return
+	}
+	defaultRules := util.GetDefaultIgnoreRules(componentType)
+	var patterns []string
+	for _, rule := range rules {
+		if !dfutil.In(defaultRules, rule) {
+			patterns = append(patterns, rule)
+		}
+	}
+	warnings, err := util.ValidatePatterns(directory, patterns)
+	if err != nil {
+		klog.V(4).Infof("unable to validate the patterns of the %s file: %v", util.DotOdoIgnoreFile, err)
+		return
+	}
+	for _, warning := range warnings {
+		log.Warning(warning)
+	}
+}
+
 func (o *DevOptions) followLogs(
 	ctx context.Context,
 ) error {
@@ -420,6 +470,7 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 	devCmd.Flags().BoolVar(&o.noCommandsFlag, "no-commands", false, "Do not run any commands; just start the development environment.")
 	devCmd.Flags().BoolVar(&o.syncGitDirFlag, "sync-git-dir", false, "Synchronize the .git directory to the container. By default, this directory is not synchronized.")
 	devCmd.Flags().BoolVar(&o.logsFlag, "logs", false, "Follow logs of component")
+	devCmd.Flags().BoolVar(&o.checkIgnoresFlag, "check-ignores", false, "Warn about the patterns of the .odoignore file which do not match any file")
 	devCmd.Flags().BoolVar(&o.apiServerFlag, "api-server", true, "Start the API Server")
 	devCmd.Flags().IntVar(&o.apiServerPortFlag, "api-server-port", 0, "Define custom port for API Server; this flag should be used in combination with --api-server flag.")
 
@@ -444,6 +495,7 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 	devCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	commonflags.UseVariablesFlags(devCmd)
 	commonflags.UsePlatformFlag(devCmd)
+	commonflags.UseOutputFlag(devCmd)
 	return devCmd
 }
 
@@ -3,6 +3,8 @@ package errors
 import (
 	"errors"
 	"fmt"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 )
 
 type NoCommandInDevfileError struct {
@@ -19,6 +21,10 @@ func (o NoCommandInDevfileError) Error() string {
 	return fmt.Sprintf("no command of kind %q found in the devfile", o.command)
 }
 
+func (o NoCommandInDevfileError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
 type NoCommandNameInDevfileError struct {
 	name string
 }
@@ -33,6 +39,10 @@ func (o NoCommandNameInDevfileError) Error() string {
 	return fmt.Sprintf("no command named %q found in the devfile", o.name)
 }
 
+func (o NoCommandNameInDevfileError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
 type Warning struct {
 	msg string
 	err error
@@ -6,10 +6,11 @@ import (
 	"io"
 	"os"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/project"
 	"github.com/spf13/cobra"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
@@ -35,6 +36,9 @@ type NamespaceListOptions struct {
 	clientset *clientset.Clientset
 
 	commandName string
+
+	// Flags
+	noHeadersFlag bool
 }
 
 var _ genericclioptions.Runnable = (*NamespaceListOptions)(nil)
@@ -60,12 +64,15 @@ func (plo *NamespaceListOptions) Validate(ctx context.Context) (err error) {
 
 // Run contains the logic for the odo list project command
 func (plo *NamespaceListOptions) Run(_ context.Context) error {
-	resourcesList, err := plo.run()
+	namespaces, err := plo.clientset.ProjectClient.List()
 	if err != nil {
 		return err
 	}
 
-	return HumanReadableOutput(os.Stdout, resourcesList, plo.commandName)
+	return HumanReadableOutput(os.Stdout, namespaces, plo.commandName, ui.TableOptions{
+		NoHeaders: plo.noHeadersFlag,
+		MaxWidth:  ui.TerminalWidth(os.Stdout),
+	})
 }
 
 func (plo *NamespaceListOptions) run() (api.ResourcesList, error) {
@@ -114,25 +121,17 @@ func NewCmdNamespaceList(name, fullName string, testClientset clientset.Clientse
 		Aliases: []string{"namespaces", "project", "projects"},
 	}
 	clientset.Add(projectListCmd, clientset.PROJECT)
+	projectListCmd.Flags().BoolVar(&o.noHeadersFlag, "no-headers", false, "Do not print the headers of the table")
 	commonflags.UseOutputFlag(projectListCmd)
 
 	return projectListCmd
 }
 
 // HumanReadableOutput outputs the list of namespaces in a human readable format
-func HumanReadableOutput(w io.Writer, o api.ResourcesList, commandName string) error {
-	if len(o.Namespaces) == 0 {
+func HumanReadableOutput(w io.Writer, list project.ProjectList, commandName string, options ui.TableOptions) error {
+	if len(list.Items) == 0 {
 		return fmt.Errorf("you are not a member of any %[1]ss. You can request a %[1]s to be created using the `odo create %[1]s <%[1]s_name>` command", commandName)
 	}
-	wr := tabwriter.NewWriter(w, 5, 2, 3, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(wr, "ACTIVE", "\t", "NAME")
-	for _, project := range o.Namespaces {
-		activeMark := " "
-		if project.Active {
-			activeMark = "*"
-		}
-		fmt.Fprintln(wr, activeMark, "\t", project.Name)
-	}
-	wr.Flush()
+	ui.RenderTabular(w, list, options)
 	return nil
 }
@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 
-	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cli/ui"
@@ -47,6 +47,7 @@ type ServiceListOptions struct {
 	// flags
 	namespaceFlag     string
 	allNamespacesFlag bool
+	noHeadersFlag     bool
 }
 
 var _ genericclioptions.Runnable = (*ServiceListOptions)(nil)
@@ -88,7 +89,10 @@ func (o *ServiceListOptions) Run(_ context.Context) error {
 		return err
 	}
 	s.End(true)
-	HumanReadable(services)
+	HumanReadable(services, ui.TableOptions{
+		NoHeaders: o.noHeadersFlag,
+		MaxWidth:  ui.TerminalWidth(os.Stdout),
+	})
 	return nil
 }
 
@@ -138,18 +142,30 @@ func (o *ServiceListOptions) RunForJsonOutput(_ context.Context) (out interface{
 	return o.run()
 }
 
-func HumanReadable(services api.ResourcesList) {
+func HumanReadable(services api.ResourcesList, options ui.TableOptions) {
 	if len(services.BindableServices) == 0 {
 		log.Error("no bindable Operator backed services found")
 		return
 	}
 	fmt.Println()
-	t := ui.NewTable()
-	t.AppendHeader(table.Row{"NAME", "NAMESPACE"})
-	for _, svc := range services.BindableServices {
-		t.AppendRow(table.Row{svc.Service, svc.Namespace})
+	ui.RenderTabular(os.Stdout, bindableServices(services.BindableServices), options)
+}
+
+// bindableServices is the list of bindable services displayed as a table
+type bindableServices []api.BindableService
+
+var _ ui.Tabular = bindableServices(nil)
+
+func (o bindableServices) Headers() []string {
+	return []string{"NAME", "NAMESPACE"}
+}
+
+func (o bindableServices) Rows() [][]string {
+	rows := make([][]string, 0, len(o))
+	for _, svc := range o {
+		rows = append(rows, []string{svc.Service, svc.Namespace})
 	}
-	t.Render()
+	return rows
 }
 
 func NewServicesListOptions() *ServiceListOptions {
@@ -172,6 +188,7 @@ func NewCmdServicesList(name, fullName string, testClientset clientset.Clientset
 	clientset.Add(servicesListCmd, clientset.PROJECT, clientset.BINDING, clientset.FILESYSTEM)
 	servicesListCmd.Flags().BoolVarP(&o.allNamespacesFlag, "all-namespaces", "A", false, "Show bindable services from all namespaces")
 	servicesListCmd.Flags().StringVarP(&o.namespaceFlag, "namespace", "n", "", "Show bindable services from a specific namespace (uses current namespace in kubeconfig by default)")
+	servicesListCmd.Flags().BoolVar(&o.noHeadersFlag, "no-headers", false, "Do not print the headers of the table")
 	commonflags.UseOutputFlag(servicesListCmd)
 	return servicesListCmd
 }
@@ -3,14 +3,18 @@ package login
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/auth"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	"github.com/redhat-developer/odo/pkg/odo/util"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
 	"github.com/spf13/cobra"
+	"k8s.io/klog"
 	"k8s.io/kubectl/pkg/util/templates"
 )
 
@@ -87,7 +91,30 @@ func (o *LoginOptions) Validate(ctx context.Context) (err error) {
 
 // Run contains the logic for the odo command
 func (o *LoginOptions) Run(ctx context.Context) (err error) {
-	return o.loginClient.Login(o.serverFlag, o.userNameFlag, o.passwordFlag, o.tokenFlag, o.caAuthFlag, o.skipTlsFlag)
+	err = o.loginClient.Login(o.serverFlag, o.userNameFlag, o.passwordFlag, o.tokenFlag, o.caAuthFlag, o.skipTlsFlag)
+	if err != nil {
+		return err
+	}
+	printSessionLifetime()
+	return nil
+}
+
+// printSessionLifetime displays the lifetime of the token granted by the login, if it can be determined
+func printSessionLifetime() {
+	client, err := kclient.New()
+	if err != nil {
+		klog.V(4).Infof("unable to create a client to get the lifetime of the token: %v", err)
+		return
+	}
+	validUntil, err := client.TokenValidUntil()
+	if err != nil {
+		klog.V(4).Infof("unable to get the lifetime of the token: %v", err)
+		return
+	}
+	if validUntil.IsZero() {
+		return
+	}
+	log.Infof("Your session expires in %s", time.Until(validUntil).Round(time.Minute))
 }
 
 // NewCmdLogin implements the odo command
@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"golang.org/x/term"
+	"sigs.k8s.io/yaml"
+)
+
+// Tabular is implemented by the lists which can be rendered as a table
+type Tabular interface {
+	// Headers returns the names of the columns
+	Headers() []string
+	// Rows returns the values of the columns for each item of the list
+	Rows() [][]string
+}
+
+// TableOptions defines how a table is rendered
+type TableOptions struct {
+	// NoHeaders hides the headers, to make the output easier to parse by scripts
+	NoHeaders bool
+	// MaxWidth is the maximal length of the lines; longer lines are truncated.
+	// Lines are not truncated if MaxWidth is 0
+	MaxWidth int
+	// EmptyMessage is displayed instead of the table when there are no rows
+	EmptyMessage string
+}
+
+// RenderTable writes the rows as a table to w, with the headers unless options.NoHeaders is set.
+// When there are no rows, only options.EmptyMessage is written
+func RenderTable(w io.Writer, headers []string, rows [][]string, options TableOptions) {
+	if len(rows) == 0 {
+		if options.EmptyMessage != "" {
+			fmt.Fprintln(w, options.EmptyMessage)
+		}
+		return
+	}
+
+	t := NewTable()
+	t.SetOutputMirror(w)
+	if !options.NoHeaders {
+		t.AppendHeader(toRow(headers))
+	}
+	for _, row := range rows {
+		t.AppendRow(toRow(row))
+	}
+	if options.MaxWidth > 0 {
+		t.SetAllowedRowLength(options.MaxWidth)
+	}
+	t.Render()
+}
+
+// RenderTabular writes the list as a table to w, see RenderTable
+func RenderTabular(w io.Writer, list Tabular, options TableOptions) {
+	RenderTable(w, list.Headers(), list.Rows(), options)
+}
+
+// RenderJSON writes v in JSON format to w. A nil slice is written as an empty array
+func RenderJSON(w io.Writer, v interface{}) error {
+	data, err := json.MarshalIndent(emptyIfNil(v), "", "\t")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// RenderYAML writes v in YAML format to w. A nil slice is written as an empty array
+func RenderYAML(w io.Writer, v interface{}) error {
+	data, err := yaml.Marshal(emptyIfNil(v))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// TerminalWidth returns the width of the terminal w is writing to, or 0 if w is not a terminal
+func TerminalWidth(w io.Writer) int {
+	f, ok := w.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return 0
+	}
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// emptyIfNil returns an empty slice of the same type if v is a nil slice,
+// so that empty lists are rendered as [] instead of null
+func emptyIfNil(v interface{}) interface{} {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Slice && value.IsNil() {
+		return reflect.MakeSlice(value.Type(), 0, 0).Interface()
+	}
+	return v
+}
+
+func toRow(values []string) table.Row {
+	row := make(table.Row, 0, len(values))
+	for _, value := range values {
+		row = append(row, value)
+	}
+	return row
+}
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jedib0t/go-pretty/v6/text"
+)
+
+type fakeItem struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type fakeList []fakeItem
+
+func (o fakeList) Headers() []string {
+	return []string{"NAME", "NAMESPACE"}
+}
+
+func (o fakeList) Rows() [][]string {
+	rows := make([][]string, 0, len(o))
+	for _, item := range o {
+		rows = append(rows, []string{item.Name, item.Namespace})
+	}
+	return rows
+}
+
+var items = fakeList{
+	{Name: "a-long-service-name", Namespace: "ns1"},
+	{Name: "svc", Namespace: "ns2"},
+}
+
+func checkGolden(t *testing.T, golden string, got string) {
+	t.Helper()
+	want, err := os.ReadFile(filepath.Join("testdata", golden))
+	if err != nil {
+		t.Fatalf("unable to read golden file: %v", err)
+	}
+	if diff := cmp.Diff(string(want), got); diff != "" {
+		t.Errorf("output mismatch with %s (-want +got):\n%s", golden, diff)
+	}
+}
+
+func TestRenderTabular(t *testing.T) {
+	text.DisableColors()
+	defer text.EnableColors()
+
+	tests := []struct {
+		name    string
+		golden  string
+		list    fakeList
+		options TableOptions
+	}{
+		{
+			name:   "with headers",
+			golden: "table.txt",
+			list:   items,
+		},
+		{
+			name:    "without headers",
+			golden:  "table_no_headers.txt",
+			list:    items,
+			options: TableOptions{NoHeaders: true},
+		},
+		{
+			name:    "truncated lines",
+			golden:  "table_truncated.txt",
+			list:    items,
+			options: TableOptions{MaxWidth: 10},
+		},
+		{
+			name:    "empty list with a message",
+			golden:  "table_empty.txt",
+			options: TableOptions{EmptyMessage: "no services found"},
+		},
+		{
+			name:   "empty list without message",
+			golden: "table_empty_no_message.txt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			RenderTabular(&out, tt.list, tt.options)
+			checkGolden(t, tt.golden, out.String())
+		})
+	}
+}
+
+func TestRenderJSONAndYAML(t *testing.T) {
+	renderers := map[string]func(w io.Writer, v interface{}) error{
+		"json": RenderJSON,
+		"yaml": RenderYAML,
+	}
+	tests := []struct {
+		name   string
+		golden string
+		value  interface{}
+	}{
+		{
+			name:   "list of items",
+			golden: "list",
+			value:  items,
+		},
+		{
+			name:   "nil list",
+			golden: "empty_list",
+			value:  fakeList(nil),
+		},
+		{
+			name:   "empty list",
+			golden: "empty_list",
+			value:  fakeList{},
+		},
+	}
+	for format, render := range renderers {
+		for _, tt := range tests {
+			t.Run(format+"/"+tt.name, func(t *testing.T) {
+				var out bytes.Buffer
+				if err := render(&out, tt.value); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				checkGolden(t, tt.golden+"."+format, out.String())
+			})
+		}
+	}
+}
@@ -1,57 +1,163 @@
 package version
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
-
-	dfutil "github.com/devfile/library/v2/pkg/util"
+	"k8s.io/klog"
 )
 
 const (
 	// VersionFetchURL is the URL to fetch latest version number
 	VersionFetchURL = "https://raw.githubusercontent.com/redhat-developer/odo/main/build/VERSION"
+
+	// releaseCacheTTL is the duration during which the latest release information is cached
+	releaseCacheTTL = 24 * time.Hour
 )
 
-// getLatestReleaseTag polls odo's upstream GitHub repository to get the
-// tag of the latest release
-func getLatestReleaseTag() (string, error) {
+// ReleaseInfo contains information about the latest release of odo
+type ReleaseInfo struct {
+	// CurrentVersion is the version of the running odo binary
+	CurrentVersion string
+	// LatestVersion is the version of the latest release
+	LatestVersion string
+	// UpdateAvailable is true if LatestVersion is newer than CurrentVersion
+	UpdateAvailable bool
+}
 
-	request := dfutil.HTTPRequestParams{
-		URL: VersionFetchURL,
-	}
+// releaseCache is the content of the file caching the latest release information
+type releaseCache struct {
+	URL           string    `json:"url"`
+	LatestVersion string    `json:"latestVersion"`
+	FetchedAt     time.Time `json:"fetchedAt"`
+}
 
-	// Make request and cache response for 60 minutes
-	body, err := dfutil.HTTPGetRequest(request, 60)
+// releaseChecker gets the latest release of odo from url,
+// caching the result in cacheFile for ttl
+type releaseChecker struct {
+	url       string
+	cacheFile string
+	ttl       time.Duration
+	now       func() time.Time
+}
+
+// CheckLatestRelease returns information about the latest release of odo, compared to currentVersion.
+// The latest release is fetched from VersionFetchURL, within timeout, and cached on disk for 24 hours.
+func CheckLatestRelease(currentVersion string, timeout time.Duration) (*ReleaseInfo, error) {
+	cacheDir, err := os.UserCacheDir()
 	if err != nil {
-		return "", fmt.Errorf("error getting latest release: %w", err)
+		cacheDir = os.TempDir()
 	}
-
-	return strings.TrimSuffix(string(body), "\n"), nil
+	checker := releaseChecker{
+		url:       VersionFetchURL,
+		cacheFile: filepath.Join(cacheDir, "odo", "latest-release.json"),
+		ttl:       releaseCacheTTL,
+		now:       time.Now,
+	}
+	return checker.check(currentVersion, timeout)
 }
 
-// checkLatestReleaseTag returns the latest release tag if a newer latest
-// release is available, else returns an empty string
-func checkLatestReleaseTag(currentVersion string) (string, error) {
+func (o releaseChecker) check(currentVersion string, timeout time.Duration) (*ReleaseInfo, error) {
 	currentSemver, err := semver.Make(strings.TrimPrefix(currentVersion, "v"))
 	if err != nil {
-		return "", fmt.Errorf("unable to make semver from the current version: %v: %w", currentVersion, err)
+		return nil, fmt.Errorf("unable to make semver from the current version: %v: %w", currentVersion, err)
 	}
 
-	latestTag, err := getLatestReleaseTag()
+	latestTag, err := o.getLatestReleaseTag(timeout)
 	if err != nil {
-		return "", fmt.Errorf("unable to get latest release tag: %w", err)
+		return nil, fmt.Errorf("unable to get latest release tag: %w", err)
 	}
 
 	latestSemver, err := semver.Make(strings.TrimPrefix(latestTag, "v"))
 	if err != nil {
+		return nil, fmt.Errorf("unable to make semver from the latest release tag: %v: %w", latestTag, err)
+	}
+
+	return &ReleaseInfo{
+		CurrentVersion:  currentVersion,
+		LatestVersion:   latestTag,
+		UpdateAvailable: currentSemver.LT(latestSemver),
+	}, nil
+}
+
+// getLatestReleaseTag returns the tag of the latest release, from the cache if it is fresh enough,
+// or else polls odo's upstream GitHub repository and caches the result
+func (o releaseChecker) getLatestReleaseTag(timeout time.Duration) (string, error) {
+	if cached, ok := o.readCache(); ok {
+		klog.V(4).Infof("using latest release %q cached at %s", cached.LatestVersion, cached.FetchedAt)
+		return cached.LatestVersion, nil
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+		Timeout: timeout,
+	}
+	resp, err := client.Get(o.url)
+	if err != nil {
+		return "", fmt.Errorf("error getting latest release: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error getting latest release: unexpected status %q", resp.Status)
+	}
+
+	// a version is a single line, no need to read more
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 256))
+	if err != nil {
+		return "", fmt.Errorf("error getting latest release: %w", err)
+	}
+	latestTag := strings.TrimSpace(string(body))
+	if _, err = semver.Make(strings.TrimPrefix(latestTag, "v")); err != nil {
 		return "", fmt.Errorf("unable to make semver from the latest release tag: %v: %w", latestTag, err)
 	}
 
-	if currentSemver.LT(latestSemver) {
-		return latestTag, nil
+	o.writeCache(latestTag)
+	return latestTag, nil
+}
+
+// readCache returns the cached latest release, if it has been fetched from the same URL and is not expired
+func (o releaseChecker) readCache() (releaseCache, bool) {
+	var cached releaseCache
+	content, err := os.ReadFile(o.cacheFile)
+	if err != nil {
+		return cached, false
+	}
+	if err = json.Unmarshal(content, &cached); err != nil {
+		klog.V(4).Infof("ignoring invalid latest release cache %s: %v", o.cacheFile, err)
+		return cached, false
 	}
+	if cached.URL != o.url || cached.LatestVersion == "" || o.now().Sub(cached.FetchedAt) > o.ttl {
+		return cached, false
+	}
+	return cached, true
+}
 
-	return "", nil
+// writeCache caches the latest release. Errors are only logged, as caching is not critical
+func (o releaseChecker) writeCache(latestTag string) {
+	content, err := json.Marshal(releaseCache{
+		URL:           o.url,
+		LatestVersion: latestTag,
+		FetchedAt:     o.now(),
+	})
+	if err != nil {
+		klog.V(4).Infof("unable to cache latest release: %v", err)
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(o.cacheFile), 0750); err != nil {
+		klog.V(4).Infof("unable to cache latest release: %v", err)
+		return
+	}
+	if err = os.WriteFile(o.cacheFile, content, 0600); err != nil {
+		klog.V(4).Infof("unable to cache latest release: %v", err)
+	}
 }
@@ -1,36 +1,185 @@
 package version
 
-/*
 import (
 	"fmt"
-	"github.com/blang/semver"
-	"strings"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
-func Test_getLatestReleaseTag(t *testing.T) {
+func Test_releaseChecker_check(t *testing.T) {
 	tests := []struct {
-		name    string
-		success bool
+		name           string
+		currentVersion string
+		response       string
+		status         int
+		// cache is the content of the cache file, if any
+		cache string
+		// cacheAge is the age of the cached content
+		cacheAge    time.Duration
+		want        *ReleaseInfo
+		wantErr     bool
+		wantFetches int
 	}{
 		{
-			name:    "parse version string and see if it returns a validated Version",
-			success: true,
+			name:           "fresh fetch of a newer release",
+			currentVersion: "v3.15.0",
+			response:       "v3.16.0\n",
+			status:         http.StatusOK,
+			want: &ReleaseInfo{
+				CurrentVersion:  "v3.15.0",
+				LatestVersion:   "v3.16.0",
+				UpdateAvailable: true,
+			},
+			wantFetches: 1,
+		},
+		{
+			name:           "fresh fetch of the current release",
+			currentVersion: "v3.16.0",
+			response:       "v3.16.0\n",
+			status:         http.StatusOK,
+			want: &ReleaseInfo{
+				CurrentVersion:  "v3.16.0",
+				LatestVersion:   "v3.16.0",
+				UpdateAvailable: false,
+			},
+			wantFetches: 1,
+		},
+		{
+			name:           "cache hit",
+			currentVersion: "v3.15.0",
+			response:       "v3.17.0\n",
+			status:         http.StatusOK,
+			cache:          "v3.16.0",
+			cacheAge:       time.Hour,
+			want: &ReleaseInfo{
+				CurrentVersion:  "v3.15.0",
+				LatestVersion:   "v3.16.0",
+				UpdateAvailable: true,
+			},
+			wantFetches: 0,
+		},
+		{
+			name:           "expired cache",
+			currentVersion: "v3.15.0",
+			response:       "v3.17.0\n",
+			status:         http.StatusOK,
+			cache:          "v3.16.0",
+			cacheAge:       25 * time.Hour,
+			want: &ReleaseInfo{
+				CurrentVersion:  "v3.15.0",
+				LatestVersion:   "v3.17.0",
+				UpdateAvailable: true,
+			},
+			wantFetches: 1,
+		},
+		{
+			name:           "malformed response",
+			currentVersion: "v3.15.0",
+			response:       "<html>Not a version</html>",
+			status:         http.StatusOK,
+			wantErr:        true,
+			wantFetches:    1,
+		},
+		{
+			name:           "error response",
+			currentVersion: "v3.15.0",
+			response:       "v3.16.0",
+			status:         http.StatusInternalServerError,
+			wantErr:        true,
+			wantFetches:    1,
+		},
+		{
+			name:           "malformed current version",
+			currentVersion: "dev",
+			response:       "v3.16.0",
+			status:         http.StatusOK,
+			wantErr:        true,
+			wantFetches:    0,
 		},
 	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetches := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fetches++
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.response)
+			}))
+			defer server.Close()
 
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			releaseTag, err := getLatestReleaseTag()
+			now := time.Now()
+			checker := releaseChecker{
+				url:       server.URL,
+				cacheFile: filepath.Join(t.TempDir(), "odo", "latest-release.json"),
+				ttl:       releaseCacheTTL,
+				now: func() time.Time {
+					return now
+				},
+			}
+			if tt.cache != "" {
+				checker.now = func() time.Time {
+					return now.Add(-tt.cacheAge)
+				}
+				checker.writeCache(tt.cache)
+				checker.now = func() time.Time {
+					return now
+				}
+			}
 
-			if test.success == true && err == nil {
-				Semver, err := semver.Make(strings.TrimPrefix(releaseTag, "v"))
-				if err != nil {
-					t.Errorf("unable to make semver from the latest release tag: %v", releaseTag)
+			got, err := checker.check(tt.currentVersion, time.Second)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if fetches != tt.wantFetches {
+				t.Errorf("expected %d fetches, got %d", tt.wantFetches, fetches)
+			}
+			if tt.wantErr {
+				if _, err = os.Stat(checker.cacheFile); tt.cache == "" && err == nil {
+					t.Errorf("no release should have been cached on error")
 				}
-				t.Log(fmt.Sprintf("getLatestReleaseTag() returned %v\n", Semver))
+				return
+			}
+			if *got != *tt.want {
+				t.Errorf("check() = %+v, want %+v", got, tt.want)
+			}
+
+			// a second check must be served from the cache
+			_, err = checker.check(tt.currentVersion, time.Second)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fetches != tt.wantFetches {
+				t.Errorf("expected the second check to use the cache, got %d fetches", fetches)
 			}
 		})
 	}
 }
-*/
+
+func Test_releaseChecker_corruptedCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "v3.16.0")
+	}))
+	defer server.Close()
+
+	checker := releaseChecker{
+		url:       server.URL,
+		cacheFile: filepath.Join(t.TempDir(), "latest-release.json"),
+		ttl:       releaseCacheTTL,
+		now:       time.Now,
+	}
+	if err := os.WriteFile(checker.cacheFile, []byte("{not json"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := checker.check("v3.15.0", time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.LatestVersion != "v3.16.0" {
+		t.Errorf("expected latest version to be fetched, got %q", got.LatestVersion)
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	"github.com/redhat-developer/odo/pkg/podman"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
@@ -33,18 +35,24 @@ var versionLongDesc = ktemplates.LongDesc("Print the client version information"
 
 var versionExample = ktemplates.Examples(`
 # Print the client version of odo
-%[1]s`,
+%[1]s
+
+# Print the client version of odo and check the backends used to build images
+%[1]s --client --image-backends`,
 )
 
 // VersionOptions encapsulates all options for odo version command
 type VersionOptions struct {
 	// Flags
-	clientFlag bool
+	clientFlag        bool
+	imageBackendsFlag bool
 
 	// serverInfo contains the remote server information if the user asked for it, nil otherwise
 	serverInfo *kclient.ServerInfo
 	podmanInfo podman.SystemVersionReport
-	clientset  *clientset.Clientset
+	// imageBackends contains the diagnosis of the image backends if the user asked for it, nil otherwise
+	imageBackends []image.BackendDiagnosis
+	clientset     *clientset.Clientset
 }
 
 var _ genericclioptions.Runnable = (*VersionOptions)(nil)
@@ -61,6 +69,10 @@ func (o *VersionOptions) SetClientset(clientset *clientset.Clientset) {
 
 // Complete completes VersionOptions after they have been created
 func (o *VersionOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
+	if o.imageBackendsFlag {
+		o.imageBackends = image.Diagnose(ctx, o.clientset.FS)
+	}
+
 	if o.clientFlag {
 		return nil
 	}
@@ -104,6 +116,15 @@ func (o *VersionOptions) run() api.OdoVersion {
 		GitCommit: odoversion.GITCOMMIT,
 	}
 
+	for _, diagnosis := range o.imageBackends {
+		result.ImageBackends = append(result.ImageBackends, api.ImageBackendInfo{
+			Name:      diagnosis.Name,
+			Available: diagnosis.Available,
+			Version:   diagnosis.Version,
+			Error:     diagnosis.Error,
+		})
+	}
+
 	if o.clientFlag {
 		return result
 	}
@@ -141,6 +162,7 @@ func (o *VersionOptions) Run(ctx context.Context) (err error) {
 	fmt.Println("odo " + odoVersion.Version + " (" + odoVersion.GitCommit + ")")
 
 	if o.clientFlag {
+		printImageBackends(odoVersion.ImageBackends)
 		return nil
 	}
 
@@ -163,10 +185,27 @@ func (o *VersionOptions) Run(ctx context.Context) (err error) {
 	}
 
 	fmt.Print(message)
+	printImageBackends(odoVersion.ImageBackends)
 
 	return nil
 }
 
+// printImageBackends prints the diagnosis of the image backends, if any
+func printImageBackends(backends []api.ImageBackendInfo) {
+	if len(backends) == 0 {
+		return
+	}
+	message := "\nImage backends:\n"
+	for _, backend := range backends {
+		if backend.Available {
+			message += fmt.Sprintf(" - %s: available (%s)\n", backend.Name, backend.Version)
+		} else {
+			message += fmt.Sprintf(" - %s: not available: %s\n", backend.Name, backend.Error)
+		}
+	}
+	fmt.Print(message)
+}
+
 // NewCmdVersion implements the version odo command
 func NewCmdVersion(name, fullName string, testClientset clientset.Clientset) *cobra.Command {
 	o := NewVersionOptions()
@@ -181,31 +220,36 @@ func NewCmdVersion(name, fullName string, testClientset clientset.Clientset) *co
 		},
 	}
 	commonflags.UseOutputFlag(versionCmd)
-	clientset.Add(versionCmd, clientset.PREFERENCE, clientset.KUBERNETES_NULLABLE, clientset.PODMAN_NULLABLE)
+	clientset.Add(versionCmd, clientset.PREFERENCE, clientset.KUBERNETES_NULLABLE, clientset.PODMAN_NULLABLE, clientset.FILESYSTEM)
 	util.SetCommandGroup(versionCmd, util.UtilityGroup)
 
 	versionCmd.SetUsageTemplate(util.CmdUsageTemplate)
 	versionCmd.Flags().BoolVar(&o.clientFlag, "client", false, "Client version only (no server required).")
+	versionCmd.Flags().BoolVar(&o.imageBackendsFlag, "image-backends", false, "Check that the backends used to build images (Podman and Docker) are available.")
 
 	return versionCmd
 }
 
+// latestReleaseTimeout is the maximum duration to get information about the latest release
+const latestReleaseTimeout = 10 * time.Second
+
 // GetLatestReleaseInfo Gets information about the latest release
 func GetLatestReleaseInfo(info chan<- string) {
-	newTag, err := checkLatestReleaseTag(odoversion.VERSION)
+	releaseInfo, err := CheckLatestRelease(odoversion.VERSION, latestReleaseTimeout)
 	if err != nil {
 		// The error is intentionally not being handled because we don't want
 		// to stop the execution of the program because of this failure
 		klog.V(4).Infof("Error checking if newer odo release is available: %v", err)
+		return
 	}
-	if len(newTag) > 0 {
+	if releaseInfo.UpdateAvailable {
 		info <- fmt.Sprintf(`
 ---
 A newer version of odo (%s) is available,
 visit %s to update.
 If you wish to disable this notification, run:
 odo preference set UpdateNotification false
----`, fmt.Sprint(newTag), OdoReleasesPage)
+---`, releaseInfo.LatestVersion, OdoReleasesPage)
 
 	}
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog"
 
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/configAutomount"
 	"github.com/redhat-developer/odo/pkg/dev/kubedev"
 	"github.com/redhat-developer/odo/pkg/dev/podmandev"
@@ -219,7 +220,8 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 		if testClientset.KubernetesClient != nil {
 			dep.KubernetesClient = testClientset.KubernetesClient
 		} else {
-			dep.KubernetesClient, err = kclient.New()
+			var kubeClient *kclient.Client
+			kubeClient, err = kclient.New()
 			if err != nil {
 				// only return error is KUBERNETES_NULLABLE is not defined in combination with KUBERNETES
 				if isDefined(command, KUBERNETES) && !isDefined(command, KUBERNETES_NULLABLE) {
@@ -227,6 +229,15 @@ func Fetch(command *cobra.Command, platform string, testClientset Clientset) (*C
 				}
 				klog.V(3).Infof("no Kubernetes client initialized: %v", err)
 				dep.KubernetesClient = nil
+			} else {
+				envConfig := envcontext.GetEnvConfig(ctx)
+				kubeClient.Timeouts = kclient.Timeouts{
+					ResourceDeletion: envConfig.OdoResourceDeletionTimeout,
+					ServiceAccount:   envConfig.OdoServiceAccountTimeout,
+					Secret:           envConfig.OdoSecretTimeout,
+					Retry:            envConfig.OdoRetryTimeout,
+				}
+				dep.KubernetesClient = kubeClient
 			}
 		}
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/redhat-developer/odo/pkg/devfile/location"
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
@@ -36,6 +37,10 @@ Visit https://odo.dev for more information.`
 	return message
 }
 
+func (o NoDevfileError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
 func IsNoDevfileError(err error) bool {
 	_, ok := err.(NoDevfileError)
 	return ok
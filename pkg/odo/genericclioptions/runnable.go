@@ -14,6 +14,7 @@ import (
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	"gopkg.in/AlecAivazis/survey.v1/terminal"
 
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/redhat-developer/odo/pkg/version"
 
@@ -24,6 +25,7 @@ import (
 
 	"gopkg.in/AlecAivazis/survey.v1"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/klog"
 	"k8s.io/utils/pointer"
 
@@ -84,15 +86,19 @@ const (
 	defaultAppName = "app"
 )
 
-func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Command, args []string) error {
+func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Command, args []string) (err error) {
 	var (
-		err             error
 		startTime       = time.Now()
 		ctx, cancelFunc = context.WithCancel(cmd.Context())
+		// server returns the URL of the cluster, reported when the cluster rejects the credentials of the user
+		server = func() string { return "" }
 	)
 
 	defer func() {
 		if err != nil {
+			if kerrors.IsUnauthorized(err) {
+				err = odoerrors.AsNotLoggedIn(err, server())
+			}
 			cmd.SilenceErrors = true
 			cmd.SilenceUsage = true
 		}
@@ -228,6 +234,13 @@ func GenericRun(o Runnable, testClientset clientset.Clientset, cmd *cobra.Comman
 	if deps.KubernetesClient != nil {
 		namespace := deps.KubernetesClient.GetCurrentNamespace()
 		ctx = odocontext.WithNamespace(ctx, namespace)
+		kubeClient := deps.KubernetesClient
+		server = func() string {
+			if config := kubeClient.GetClientConfig(); config != nil {
+				return config.Host
+			}
+			return ""
+		}
 	}
 
 	if deps.FS != nil {
@@ -20,7 +20,7 @@ const (
 // .git ignores; or find the .odoignore/.gitignore file in the directory and use that instead.
 func ApplyIgnore(ignores *[]string, sourcePath string) (err error) {
 	if len(*ignores) == 0 {
-		rules, err := dfutil.GetIgnoreRulesFromDirectory(sourcePath)
+		rules, err := pkgUtil.GetIgnoreRulesFromDirectory(sourcePath)
 		if err != nil {
 			return err
 		}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/redhat-developer/odo/pkg/api"
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/spf13/cobra"
@@ -21,13 +22,13 @@ var (
 
 func LogError(err error, context string) {
 	if err != nil {
+		// credentials rejected by the cluster are reported as such, instead of the error of the failing request
+		err = odoerrors.AsNotLoggedIn(err, "")
 		// If it's JSON, we'll output  the error
 		if log.IsJSON() {
 
-			// Machine readble error output
-			machineOutput := api.GenericError{
-				Message: err.Error(),
-			}
+			// Machine readable error output
+			machineOutput := toGenericError(odoerrors.FromError(err))
 			// Output the error
 			machineoutput.OutputError(machineOutput)
 
@@ -43,6 +44,17 @@ func LogError(err error, context string) {
 	}
 }
 
+// toGenericError returns the machine readable output of the classified error
+func toGenericError(err *odoerrors.OdoError) api.GenericError {
+	return api.GenericError{
+		Kind:        string(err.Kind),
+		Reason:      err.Reason,
+		Message:     err.Message,
+		Details:     err.Details,
+		Suggestions: err.Suggestions,
+	}
+}
+
 // LogErrorAndExit prints the given error and exits the code with an exit code of 1.
 // If the context is provided, then that is printed alongside the error.
 // *If* we are using the global json parameter, we instead output the json output
@@ -1,7 +1,15 @@
 package util
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
+	"github.com/redhat-developer/odo/pkg/kclient"
 )
 
 func TestGetFullName(t *testing.T) {
@@ -13,3 +21,54 @@ func TestGetFullName(t *testing.T) {
 		t.Errorf("test failed, expected %s, got %s", expected, actual)
 	}
 }
+
+func TestToGenericError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want api.GenericError
+	}{
+		{
+			name: "unknown error",
+			err:  errors.New("something went wrong"),
+			want: api.GenericError{
+				Kind:    "Unknown",
+				Message: "something went wrong",
+			},
+		},
+		{
+			name: "wrapped error with suggestions",
+			err:  fmt.Errorf("unable to list pods: %w", kclient.NewNoConnectionError()),
+			want: api.GenericError{
+				Kind:        "ClusterUnreachable",
+				Message:     "unable to list pods: unable to access the cluster",
+				Details:     "unable to access the cluster",
+				Suggestions: []string{"Check that the cluster is running and that the current context of your kubeconfig is correct"},
+			},
+		},
+		{
+			name: "error with a reason and its own suggestions",
+			err: &kclient.InvalidKubeconfigError{
+				KubeconfigReason: kclient.KubeconfigCertificateExpired,
+				Server:           "https://api.example.com:6443",
+				Err:              errors.New("x509: certificate has expired or is not yet valid"),
+			},
+			want: api.GenericError{
+				Kind:   "InvalidKubeconfig",
+				Reason: "CertificateExpired",
+				Message: "The client certificate used to access the cluster https://api.example.com:6443 has expired.\n" +
+					"Renew your client certificate, or log in again with `odo login https://api.example.com:6443`.\n" +
+					"Error: x509: certificate has expired or is not yet valid",
+				Suggestions: []string{"Renew your client certificate, or log in again with `odo login https://api.example.com:6443`."},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toGenericError(odoerrors.FromError(tt.err))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("toGenericError() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package platform
 
-import "fmt"
+import (
+	"fmt"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
+)
 
 // PodNotFoundError returns an error if no pod is found with the selector
 type PodNotFoundError struct {
@@ -10,3 +14,7 @@ type PodNotFoundError struct {
 func (e *PodNotFoundError) Error() string {
 	return fmt.Sprintf("pod not found for the selector: %s", e.Selector)
 }
+
+func (e *PodNotFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
@@ -33,7 +33,9 @@ type Client interface {
 	// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
 	GetRunningPodFromSelector(selector string) (*corev1.Pod, error)
 
-	GetPodUsingComponentName(componentName string) (*corev1.Pod, error)
+	// GetPodUsingComponentName returns the running pod of the component in the application.
+	// An error is returned if the labels of the pod do not identify the component and application.
+	GetPodUsingComponentName(componentName string, appName string) (*corev1.Pod, error)
 
 	PodWatcher(ctx context.Context, selector string) (watch.Interface, error)
 }
@@ -98,18 +98,18 @@ func (mr *MockClientMockRecorder) GetPodLogs(podName, containerName, followLog i
 }
 
 // GetPodUsingComponentName mocks base method.
-func (m *MockClient) GetPodUsingComponentName(componentName string) (*v1.Pod, error) {
+func (m *MockClient) GetPodUsingComponentName(componentName, appName string) (*v1.Pod, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPodUsingComponentName", componentName)
+	ret := m.ctrl.Call(m, "GetPodUsingComponentName", componentName, appName)
 	ret0, _ := ret[0].(*v1.Pod)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPodUsingComponentName indicates an expected call of GetPodUsingComponentName.
-func (mr *MockClientMockRecorder) GetPodUsingComponentName(componentName interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) GetPodUsingComponentName(componentName, appName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodUsingComponentName", reflect.TypeOf((*MockClient)(nil).GetPodUsingComponentName), componentName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodUsingComponentName", reflect.TypeOf((*MockClient)(nil).GetPodUsingComponentName), componentName, appName)
 }
 
 // GetPodsMatchingSelector mocks base method.
@@ -93,7 +93,13 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 	return components, nil
 }
 
-func (o *PodmanCli) GetPodUsingComponentName(componentName string) (*corev1.Pod, error) {
-	podSelector := fmt.Sprintf("component=%s", componentName)
-	return o.GetRunningPodFromSelector(podSelector)
+func (o *PodmanCli) GetPodUsingComponentName(componentName string, appName string) (*corev1.Pod, error) {
+	pod, err := o.GetRunningPodFromSelector(odolabels.GetPodSelector(componentName, appName))
+	if err != nil {
+		return nil, err
+	}
+	if err = odolabels.CheckPodLabels(pod.GetLabels(), componentName, appName); err != nil {
+		return nil, err
+	}
+	return pod, nil
 }
@@ -2,6 +2,8 @@ package podman
 
 import (
 	"fmt"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
 )
 
 type PodmanNotFoundError struct {
@@ -19,3 +21,7 @@ func (o PodmanNotFoundError) Error() string {
 	}
 	return fmt.Errorf("%s cause: %w", msg, o.err).Error()
 }
+
+func (o PodmanNotFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
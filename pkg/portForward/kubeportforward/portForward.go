@@ -18,6 +18,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/util"
@@ -85,7 +86,7 @@ func (o *PFClient) StartPortForwarding(ctx context.Context, devFileObj parser.De
 	for _, v1 := range portPairs {
 		portPairsSlice = append(portPairsSlice, v1...)
 	}
-	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
+	pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName, odocontext.GetApplication(ctx))
 	if err != nil {
 		return err
 	}
@@ -1,5 +1,7 @@
 package preference
 
+import odoerrors "github.com/redhat-developer/odo/pkg/errors"
+
 type MinimumDurationValueError struct{}
 
 func NewMinimumDurationValueError() MinimumDurationValueError {
@@ -9,3 +11,7 @@ func NewMinimumDurationValueError() MinimumDurationValueError {
 func (v MinimumDurationValueError) Error() string {
 	return "value must be a positive Duration (e.g. 4s, 5m, 1h); minimum value: 1s"
 }
+
+func (v MinimumDurationValueError) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
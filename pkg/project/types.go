@@ -49,3 +49,21 @@ func NewProjectList(items []Project) ProjectList {
 		Items: items,
 	}
 }
+
+// Headers returns the columns displayed for a list of projects
+func (o ProjectList) Headers() []string {
+	return []string{"ACTIVE", "NAME"}
+}
+
+// Rows returns the columns displayed for each project, the active project being marked with *
+func (o ProjectList) Rows() [][]string {
+	rows := make([][]string, 0, len(o.Items))
+	for _, project := range o.Items {
+		activeMark := ""
+		if project.Status.Active {
+			activeMark = "*"
+		}
+		rows = append(rows, []string{activeMark, project.Name})
+	}
+	return rows
+}
@@ -1,6 +1,10 @@
 package state
 
-import "fmt"
+import (
+	"fmt"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
+)
 
 type ErrAlreadyRunningOnPlatform struct {
 	platform string
@@ -17,3 +21,7 @@ func NewErrAlreadyRunningOnPlatform(platform string, pid int) ErrAlreadyRunningO
 func (e ErrAlreadyRunningOnPlatform) Error() string {
 	return fmt.Sprintf("a session with PID %d is already running on platform %q", e.pid, e.platform)
 }
+
+func (e ErrAlreadyRunningOnPlatform) Kind() odoerrors.Kind {
+	return odoerrors.KindConflict
+}
@@ -57,6 +57,24 @@ func NewStorageList(items []Storage) StorageList {
 	}
 }
 
+// Headers returns the columns displayed for a list of storages
+func (o StorageList) Headers() []string {
+	return []string{"NAME", "SIZE", "PATH", "CONTAINER", "EPHEMERAL"}
+}
+
+// Rows returns the columns displayed for each storage
+func (o StorageList) Rows() [][]string {
+	rows := make([][]string, 0, len(o.Items))
+	for _, storage := range o.Items {
+		ephemeral := "false"
+		if storage.Spec.Ephemeral != nil && *storage.Spec.Ephemeral {
+			ephemeral = "true"
+		}
+		rows = append(rows, []string{storage.Name, storage.Spec.Size, storage.Spec.Path, storage.Spec.ContainerName, ephemeral})
+	}
+	return rows
+}
+
 // NewStorage returns an instance of Storage
 // storagePath indicates the path to which the storage is mounted to, "" if not mounted
 func NewStorage(storageName, storageSize, storagePath string, ephemeral *bool) Storage {
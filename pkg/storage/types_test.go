@@ -181,3 +181,18 @@ func TestNewStorageList(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageList_Rows(t *testing.T) {
+	ephemeral := true
+	list := NewStorageList([]Storage{
+		NewStorageWithContainer("data", "1Gi", "/data", "runtime", nil),
+		NewStorageWithContainer("cache", "100Mi", "/cache", "tools", &ephemeral),
+	})
+	want := [][]string{
+		{"data", "1Gi", "/data", "runtime", "false"},
+		{"cache", "100Mi", "/cache", "tools", "true"},
+	}
+	if diff := cmp.Diff(want, list.Rows()); diff != "" {
+		t.Errorf("StorageList.Rows() mismatch (-want +got):\n%s", diff)
+	}
+}
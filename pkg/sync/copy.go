@@ -8,8 +8,10 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
@@ -26,7 +28,9 @@ import (
 // During copying binary components, localPath represent base directory path to binary and copyFiles contains path of binary
 // During copying local source components, localPath represent base directory path whereas copyFiles is empty
 // During `odo watch`, localPath represent base directory path whereas copyFiles contains list of changed Files
-func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo ComponentInfo, targetPath string, copyFiles []string, globExps []string, ret util.IndexerRet) error {
+// Files matching executablePatterns, as well as files executable locally, are made executable in the container
+// If progress is not nil, it is regularly called with the number of bytes sent to the container so far
+func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo ComponentInfo, targetPath string, copyFiles []string, globExps []string, executablePatterns []string, ret util.IndexerRet, progress ProgressFunc) error {
 
 	// Destination is set to "ToSlash" as all containers being ran within OpenShift / S2I are all
 	// Linux based and thus: "\opt\app-root\src" would not work correctly.
@@ -39,7 +43,18 @@ func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo Com
 	go func() {
 		defer writer.Close()
 
-		err := makeTar(localPath, dest, writer, copyFiles, globExps, ret, filesystem.DefaultFs{})
+		var tarWriter io.Writer = writer
+		if progress != nil {
+			total, err := estimateTarSize(localPath, copyFiles, globExps, filesystem.DefaultFs{})
+			if err != nil {
+				klog.V(4).Infof("unable to estimate the size of the files to copy: %v", err)
+			}
+			pw := newProgressWriter(writer, total, progress)
+			defer pw.done()
+			tarWriter = pw
+		}
+
+		err := makeTar(localPath, dest, tarWriter, copyFiles, globExps, executablePatterns, ret, filesystem.DefaultFs{})
 		if err != nil {
 			log.Errorf("Error while creating tar: %#v", err)
 			os.Exit(1)
@@ -51,9 +66,57 @@ func (a SyncClient) CopyFile(ctx context.Context, localPath string, compInfo Com
 		return err
 	}
 
+	executables, err := getExecutableFiles(localPath, copyFiles, globExps, executablePatterns, ret, filesystem.DefaultFs{})
+	if err != nil {
+		return err
+	}
+	a.restoreExecutablePermissions(ctx, compInfo, targetPath, executables)
 	return nil
 }
 
+// restoreExecutablePermissions makes the executables files executable in the container,
+// if the permissions set in the archive have not been kept during the extraction.
+// The executables paths are relative to targetPath.
+// This is done on a best-effort basis: the files have been synced, and a warning is displayed if they cannot be made executable.
+func (a SyncClient) restoreExecutablePermissions(ctx context.Context, compInfo ComponentInfo, targetPath string, executables []string) {
+	if len(executables) == 0 {
+		return
+	}
+
+	// The permissions of a single file are checked, as the permissions are
+	// either kept or dropped for all the files during the extraction
+	var stdout, stderr bytes.Buffer
+	cmdArr := getCmdToCheckExecutable(executables[0], targetPath)
+	err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, cmdArr, &stdout, &stderr, nil, false)
+	if err == nil {
+		return
+	}
+	klog.V(4).Infof("executable permissions have not been kept during extraction: %v", err)
+
+	cmdArr = getCmdToMakeExecutable(executables, targetPath)
+	klog.V(3).Infof("Executing command %s", strings.Join(cmdArr, " "))
+	stdout.Reset()
+	stderr.Reset()
+	err = a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, cmdArr, &stdout, &stderr, nil, false)
+	if err != nil {
+		log.Warningf("Unable to make files executable in the container: %v: %s", err, stderr.String())
+	}
+}
+
+// getCmdToCheckExecutable returns the command used to check if a file extracted into targetPath is executable
+func getCmdToCheckExecutable(executable string, targetPath string) []string {
+	return []string{"test", "-x", path.Join(targetPath, executable)}
+}
+
+// getCmdToMakeExecutable returns the command used to make the files extracted into targetPath executable
+func getCmdToMakeExecutable(executables []string, targetPath string) []string {
+	cmdArr := []string{"chmod", "+x"}
+	for _, executable := range executables {
+		cmdArr = append(cmdArr, path.Join(targetPath, executable))
+	}
+	return cmdArr
+}
+
 // ExtractProjectToComponent extracts the project archive(tar) to the target path from the reader stdin
 func (a SyncClient) ExtractProjectToComponent(ctx context.Context, containerName, podName, targetPath string, stdin io.Reader) error {
 	// cmdArr will run inside container
@@ -80,9 +143,125 @@ func checkFileExistWithFS(fileName string, fs filesystem.Filesystem) bool {
 	return !os.IsNotExist(err)
 }
 
+// tarEntry is a file to be added to the archive built by makeTar
+type tarEntry struct {
+	// srcFile is the path of the file, relative to the parent of the source directory
+	srcFile string
+	// destFile is the name of the file in the archive
+	destFile string
+	// rel is the path of the file, relative to the source directory
+	rel string
+}
+
+// getTarEntries returns the entries to add to the archive for the given files,
+// skipping duplicate and non-existing files, as well as files matching the globExps rules
+func getTarEntries(srcPath string, files []string, globExps []string, ret util.IndexerRet, fs filesystem.Filesystem) ([]tarEntry, error) {
+	srcPath = filepath.Clean(srcPath)
+	ignoreMatcher := gitignore.CompileIgnoreLines(globExps...)
+	uniquePaths := make(map[string]bool)
+	var entries []tarEntry
+	for _, fileName := range files {
+
+		if _, ok := uniquePaths[fileName]; ok {
+			continue
+		} else {
+			uniquePaths[fileName] = true
+		}
+
+		if !checkFileExistWithFS(fileName, fs) {
+			continue
+		}
+
+		// Fetch path of source file relative to that of source base path so that it can be passed to linearTar
+		// which uses path relative to base path for taro header to correctly identify file location when untarred
+		rel, err := relativeTarPath(srcPath, fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		matched := ignoreMatcher.MatchesPath(rel)
+		if matched {
+			continue
+		}
+
+		destFile := rel
+		if value, ok := ret.NewFileMap[rel]; ok && value.RemoteAttribute != "" {
+			destFile = value.RemoteAttribute
+		}
+
+		entries = append(entries, tarEntry{
+			// Now we get the source file and join it to the base directory.
+			srcFile:  filepath.Join(filepath.Base(srcPath), rel),
+			destFile: destFile,
+			rel:      rel,
+		})
+	}
+	return entries, nil
+}
+
+// estimateTarSize returns the size of the archive makeTar would produce for the same arguments.
+// The size of the headers is approximated, as long file names need additional headers.
+func estimateTarSize(srcPath string, files []string, globExps []string, fs filesystem.Filesystem) (int64, error) {
+	entries, err := getTarEntries(srcPath, files, globExps, util.IndexerRet{}, fs)
+	if err != nil {
+		return 0, err
+	}
+	// the end of an archive is marked by two empty blocks
+	size := int64(2 * tarBlockSize)
+	for _, entry := range entries {
+		fileName := filepath.Join(filepath.Dir(filepath.Clean(srcPath)), entry.srcFile)
+		stat, err := fs.Stat(fileName)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case stat.IsDir():
+			// only empty directories are added to the archive, see linearTar
+			dirEntries, err := fs.ReadDir(fileName)
+			if err != nil {
+				return 0, err
+			}
+			if len(dirEntries) == 0 {
+				size += tarBlockSize
+			}
+		case stat.Mode()&os.ModeSymlink != 0:
+			size += tarBlockSize
+		default:
+			// the content of a file is padded to a multiple of the block size
+			size += tarBlockSize + (stat.Size()+tarBlockSize-1)/tarBlockSize*tarBlockSize
+		}
+	}
+	return size, nil
+}
+
+// getExecutableFiles returns the names in the archive built by makeTar of the regular files which are executable,
+// either because they are executable locally or because they match the executablePatterns rules
+func getExecutableFiles(srcPath string, files []string, globExps []string, executablePatterns []string, ret util.IndexerRet, fs filesystem.Filesystem) ([]string, error) {
+	entries, err := getTarEntries(srcPath, files, globExps, ret, fs)
+	if err != nil {
+		return nil, err
+	}
+	executableMatcher := gitignore.CompileIgnoreLines(executablePatterns...)
+	var executables []string
+	for _, entry := range entries {
+		stat, err := fs.Stat(filepath.Join(filepath.Dir(filepath.Clean(srcPath)), entry.srcFile))
+		if err != nil {
+			return nil, err
+		}
+		if !stat.Mode().IsRegular() {
+			continue
+		}
+		if stat.Mode().Perm()&0111 != 0 || executableMatcher.MatchesPath(entry.rel) {
+			executables = append(executables, tarHeaderName(entry.destFile))
+		}
+	}
+	return executables, nil
+}
+
 // makeTar function is copied from https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L309
 // srcPath is ignored if files is set
-func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExps []string, ret util.IndexerRet, fs filesystem.Filesystem) error {
+// Files matching the executablePatterns rules are added to the archive with executable permissions
+func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExps []string, executablePatterns []string, ret util.IndexerRet, fs filesystem.Filesystem) error {
 	// TODO: use compression here?
 	tarWriter := taro.NewWriter(writer)
 	defer tarWriter.Close()
@@ -92,82 +271,67 @@ func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExp
 	// and thus \opt\app-root\src would be an invalid path. Backward slashes
 	// are converted to forward.
 	destPath = filepath.ToSlash(filepath.Clean(destPath))
-	uniquePaths := make(map[string]bool)
 	klog.V(4).Infof("makeTar arguments: srcPath: %s, destPath: %s, files: %+v", srcPath, destPath, files)
-	if len(files) != 0 {
-		ignoreMatcher := gitignore.CompileIgnoreLines(globExps...)
-		for _, fileName := range files {
-
-			if _, ok := uniquePaths[fileName]; ok {
-				continue
-			} else {
-				uniquePaths[fileName] = true
-			}
 
-			if checkFileExistWithFS(fileName, fs) {
-
-				rel, err := filepath.Rel(srcPath, fileName)
-				if err != nil {
-					return err
-				}
-
-				matched := ignoreMatcher.MatchesPath(rel)
-				if matched {
-					continue
-				}
-
-				// Fetch path of source file relative to that of source base path so that it can be passed to recursiveTar
-				// which uses path relative to base path for taro header to correctly identify file location when untarred
-
-				// now that the file exists, now we need to get the absolute path
-				fileAbsolutePath, err := dfutil.GetAbsPath(fileName)
-				if err != nil {
-					return err
-				}
-				klog.V(4).Infof("Got abs path: %s", fileAbsolutePath)
-				klog.V(4).Infof("Making %s relative to %s", srcPath, fileAbsolutePath)
-
-				// We use "FromSlash" to make this OS-based (Windows uses \, Linux & macOS use /)
-				// we get the relative path by joining the two
-				destFile, err := filepath.Rel(filepath.FromSlash(srcPath), filepath.FromSlash(fileAbsolutePath))
-				if err != nil {
-					return err
-				}
-
-				// Now we get the source file and join it to the base directory.
-				srcFile := filepath.Join(filepath.Base(srcPath), destFile)
-
-				if value, ok := ret.NewFileMap[destFile]; ok && value.RemoteAttribute != "" {
-					destFile = value.RemoteAttribute
-				}
-
-				klog.V(4).Infof("makeTar srcFile: %s", srcFile)
-				klog.V(4).Infof("makeTar destFile: %s", destFile)
-
-				// The file could be a regular file or even a folder, so use recursiveTar which handles symlinks, regular files and folders
-				err = linearTar(filepath.Dir(srcPath), srcFile, filepath.Dir(destPath), destFile, tarWriter, fs)
-				if err != nil {
-					return err
-				}
-			}
+	entries, err := getTarEntries(srcPath, files, globExps, ret, fs)
+	if err != nil {
+		return err
+	}
+	executableMatcher := gitignore.CompileIgnoreLines(executablePatterns...)
+	for _, entry := range entries {
+		klog.V(4).Infof("makeTar srcFile: %s", entry.srcFile)
+		klog.V(4).Infof("makeTar destFile: %s", entry.destFile)
+
+		// The file could be a regular file or even a folder, so use linearTar which handles symlinks, regular files and folders
+		err = linearTar(filepath.Dir(srcPath), entry.srcFile, filepath.Dir(destPath), entry.destFile, executableMatcher.MatchesPath(entry.rel), tarWriter, fs)
+		if err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// relativeTarPath returns the path of fileName relative to srcPath, using the separator of the local OS.
+// An error is returned if fileName is not located into srcPath, as it would be extracted outside of the target directory.
+func relativeTarPath(srcPath, fileName string) (string, error) {
+	// We use "FromSlash" to make this OS-based (Windows uses \, Linux & macOS use /)
+	absSrcPath, err := dfutil.GetAbsPath(filepath.FromSlash(srcPath))
+	if err != nil {
+		return "", err
+	}
+	absFileName, err := dfutil.GetAbsPath(filepath.FromSlash(fileName))
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absSrcPath, absFileName)
+	if err != nil {
+		return "", err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("file %q is not located in directory %q", fileName, srcPath)
+	}
+	return rel, nil
+}
+
+// tarHeaderName returns the name to use in a tar header for the path name.
+// The archive is extracted in a LINUX container, so the name *must* use forward slashes,
+// whatever the separator used by the local OS.
+func tarHeaderName(name string) string {
+	return path.Clean(filepath.ToSlash(name))
+}
+
 // linearTar function is a modified version of https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L319
-func linearTar(srcBase, srcFile, destBase, destFile string, tw *taro.Writer, fs filesystem.Filesystem) error {
+// If forceExecutable is true and srcFile is a regular file, it is added to the archive with executable permissions
+func linearTar(srcBase, srcFile, destBase, destFile string, forceExecutable bool, tw *taro.Writer, fs filesystem.Filesystem) error {
 	if destFile == "" {
 		return fmt.Errorf("linear Tar error, destFile cannot be empty")
 	}
 
-	klog.V(4).Infof("recursiveTar arguments: srcBase: %s, srcFile: %s, destBase: %s, destFile: %s", srcBase, srcFile, destBase, destFile)
+	klog.V(4).Infof("linearTar arguments: srcBase: %s, srcFile: %s, destBase: %s, destFile: %s", srcBase, srcFile, destBase, destFile)
 
-	// The destination is a LINUX container and thus we *must* use ToSlash in order
-	// to get the copying over done correctly..
-	destBase = filepath.ToSlash(destBase)
-	destFile = filepath.ToSlash(destFile)
+	destBase = tarHeaderName(destBase)
+	destFile = tarHeaderName(destFile)
 	klog.V(4).Infof("Corrected destinations: base: %s file: %s", destBase, destFile)
 
 	joinedPath := filepath.Join(srcBase, srcFile)
@@ -211,6 +375,9 @@ func linearTar(srcBase, srcFile, destBase, destFile string, tw *taro.Writer, fs
 			return err
 		}
 		hdr.Name = destFile
+		if forceExecutable {
+			hdr.Mode |= 0111
+		}
 
 		err = tw.WriteHeader(hdr)
 		if err != nil {
@@ -232,3 +399,60 @@ func linearTar(srcBase, srcFile, destBase, destFile string, tw *taro.Writer, fs
 
 	return nil
 }
+
+// tarBlockSize is the size of the blocks composing a tar archive
+const tarBlockSize = 512
+
+// progressInterval is the minimal duration between two calls to a ProgressFunc
+const progressInterval = 250 * time.Millisecond
+
+// progressWriter counts the bytes written to the underlying writer and reports them to a ProgressFunc,
+// at most once per progressInterval
+type progressWriter struct {
+	writer     io.Writer
+	progress   ProgressFunc
+	written    int64
+	total      int64
+	lastReport time.Time
+	now        func() time.Time
+}
+
+func newProgressWriter(writer io.Writer, total int64, progress ProgressFunc) *progressWriter {
+	return &progressWriter{
+		writer:   writer,
+		progress: progress,
+		total:    total,
+		now:      time.Now,
+	}
+}
+
+func (o *progressWriter) Write(p []byte) (int, error) {
+	n, err := o.writer.Write(p)
+	o.written += int64(n)
+	if now := o.now(); now.Sub(o.lastReport) >= progressInterval {
+		o.lastReport = now
+		o.report()
+	}
+	return n, err
+}
+
+// done reports the final number of bytes written
+func (o *progressWriter) done() {
+	o.report()
+}
+
+func (o *progressWriter) report() {
+	// the total is an estimation, make sure it is never exceeded
+	if o.written > o.total {
+		o.total = o.written
+	}
+	o.progress(o.written, o.total)
+}
+
+// FormatProgress returns a human-readable representation of the progress reported to a ProgressFunc
+func FormatProgress(written, total int64) string {
+	if total <= 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%d%%", written*100/total)
+}
@@ -0,0 +1,204 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
+)
+
+// RemotePathNotFoundError is returned when the path to copy from the container does not exist
+type RemotePathNotFoundError struct {
+	Path string
+}
+
+func (e *RemotePathNotFoundError) Error() string {
+	return fmt.Sprintf("path %q does not exist in the container", e.Path)
+}
+
+func (e *RemotePathNotFoundError) Kind() odoerrors.Kind {
+	return odoerrors.KindNotFound
+}
+
+// CopyFileFromPod copies the file or directory remotePath from the container of the component into the localPath directory.
+// The permissions of the files and the symbolic links are preserved. Entries of the archive sent by the container
+// which would be extracted outside of localPath are rejected.
+func (a SyncClient) CopyFileFromPod(ctx context.Context, compInfo ComponentInfo, remotePath, localPath string) error {
+	// The archive is created in a LINUX container, the remote path uses forward slashes
+	remotePath = path.Clean(filepath.ToSlash(remotePath))
+	cmdArr := getCmdToArchiveRemotePath(remotePath)
+
+	reader, writer := io.Pipe()
+	var stderr bytes.Buffer
+	execErr := make(chan error, 1)
+	go func() {
+		klog.V(3).Infof("Executing command %s", strings.Join(cmdArr, " "))
+		err := a.platformClient.ExecCMDInContainer(ctx, compInfo.ContainerName, compInfo.PodName, cmdArr, writer, &stderr, nil, false)
+		_ = writer.CloseWithError(err)
+		execErr <- err
+	}()
+
+	untarErr := untar(reader, localPath)
+	// stop the command in the container if the extraction failed before the end of the archive
+	_ = reader.CloseWithError(untarErr)
+	err := <-execErr
+	// the error of the command is also received by the extraction, through the pipe
+	if untarErr != nil && !errors.Is(untarErr, err) {
+		return fmt.Errorf("unable to extract %q from the container into %q: %w", remotePath, localPath, untarErr)
+	}
+	if err != nil {
+		if isRemotePathNotFound(stderr.String()) {
+			return &RemotePathNotFoundError{Path: remotePath}
+		}
+		return fmt.Errorf("unable to copy %q from the container: %w: %s", remotePath, err, stderr.String())
+	}
+	return nil
+}
+
+// getCmdToArchiveRemotePath returns the command writing to its standard output an archive of the remote path,
+// containing the entries named after the base name of the remote path
+func getCmdToArchiveRemotePath(remotePath string) []string {
+	return []string{"tar", "cf", "-", "-C", path.Dir(remotePath), path.Base(remotePath)}
+}
+
+// isRemotePathNotFound returns true if the error output of tar indicates that the path to archive does not exist
+func isRemotePathNotFound(stderr string) bool {
+	return strings.Contains(stderr, "No such file or directory")
+}
+
+// untar extracts the archive read from reader into the localPath directory.
+// An error is returned for entries, or targets of symbolic links, located outside of localPath,
+// and for entries which would be written through a symbolic link already extracted.
+func untar(reader io.Reader, localPath string) error {
+	absLocalPath, err := filepath.Abs(localPath)
+	if err != nil {
+		return err
+	}
+	tarReader := taro.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target, err := extractionPath(absLocalPath, header.Name)
+		if err != nil {
+			return err
+		}
+		mode := header.FileInfo().Mode().Perm()
+
+		switch header.Typeflag {
+		case taro.TypeDir:
+			if err = os.MkdirAll(target, mode); err != nil {
+				return err
+			}
+		case taro.TypeReg:
+			if err = extractFile(tarReader, target, mode); err != nil {
+				return err
+			}
+		case taro.TypeSymlink:
+			linkTarget := header.Linkname
+			if !filepath.IsAbs(linkTarget) {
+				linkTarget = filepath.Join(filepath.Dir(target), filepath.FromSlash(linkTarget))
+			}
+			if !isInDirectory(absLocalPath, linkTarget) {
+				return fmt.Errorf("symbolic link %q points to %q, outside of directory %q", header.Name, header.Linkname, localPath)
+			}
+			if err = os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err = os.Remove(target); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			if err = os.Symlink(filepath.FromSlash(header.Linkname), target); err != nil {
+				return err
+			}
+		default:
+			klog.V(4).Infof("skipping entry %q of unsupported type %q", header.Name, header.Typeflag)
+		}
+	}
+}
+
+// extractionPath returns the local path of the archive entry name, extracted into the localPath directory.
+// An error is returned if the entry would be extracted outside of localPath.
+func extractionPath(localPath, name string) (string, error) {
+	if path.IsAbs(name) {
+		return "", fmt.Errorf("entry %q of the archive has an absolute path", name)
+	}
+	target := filepath.Join(localPath, filepath.FromSlash(name))
+	if !isInDirectory(localPath, target) {
+		return "", fmt.Errorf("entry %q of the archive is located outside of directory %q", name, localPath)
+	}
+	// the checks above are lexical: a parent directory of the entry being a symbolic link
+	// could make the entry be written anywhere, by chaining links each pointing inside localPath
+	rel, err := filepath.Rel(localPath, filepath.Dir(target))
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		return target, nil
+	}
+	parent := localPath
+	for _, component := range strings.Split(rel, string(filepath.Separator)) {
+		parent = filepath.Join(parent, component)
+		info, err := os.Lstat(parent)
+		if os.IsNotExist(err) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("entry %q of the archive would be written through the symbolic link %q", name, parent)
+		}
+	}
+	return target, nil
+}
+
+// isInDirectory returns true if the cleaned path p is dir or is located into dir
+func isInDirectory(dir, p string) bool {
+	rel, err := filepath.Rel(dir, p)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// extractFile writes the content read from reader into the file target, with the given permissions
+func extractFile(reader io.Reader, target string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	// an existing symbolic link is replaced by the file, instead of writing into its target
+	if info, err := os.Lstat(target); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		if err = os.Remove(target); err != nil {
+			return err
+		}
+	}
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(file, reader); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	// the permissions given when creating the file are restricted by the umask
+	return os.Chmod(target, mode)
+}
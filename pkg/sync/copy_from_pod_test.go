@@ -0,0 +1,245 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// makeArchive returns a tar archive containing the given headers, the files having the given content
+func makeArchive(t *testing.T, headers []taro.Header, content string) []byte {
+	var buf bytes.Buffer
+	tw := taro.NewWriter(&buf)
+	for _, header := range headers {
+		header := header
+		if header.Typeflag == taro.TypeReg {
+			header.Size = int64(len(content))
+		}
+		if err := tw.WriteHeader(&header); err != nil {
+			t.Fatal(err)
+		}
+		if header.Typeflag == taro.TypeReg {
+			if _, err := tw.Write([]byte(content)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func Test_untar(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("permissions and symbolic links are not supported on Windows")
+	}
+	tests := []struct {
+		name    string
+		headers []taro.Header
+		wantErr bool
+		check   func(t *testing.T, localPath string)
+	}{
+		{
+			name: "directory with files and symbolic link",
+			headers: []taro.Header{
+				{Name: "logs/", Typeflag: taro.TypeDir, Mode: 0755},
+				{Name: "logs/app.log", Typeflag: taro.TypeReg, Mode: 0640},
+				{Name: "logs/run.sh", Typeflag: taro.TypeReg, Mode: 0755},
+				{Name: "logs/latest.log", Typeflag: taro.TypeSymlink, Linkname: "app.log"},
+			},
+			check: func(t *testing.T, localPath string) {
+				content, err := os.ReadFile(filepath.Join(localPath, "logs", "latest.log"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(content) != "content" {
+					t.Errorf("expected content of the target of the symbolic link, got %q", string(content))
+				}
+				for file, want := range map[string]os.FileMode{"app.log": 0640, "run.sh": 0755} {
+					info, err := os.Stat(filepath.Join(localPath, "logs", file))
+					if err != nil {
+						t.Fatal(err)
+					}
+					if got := info.Mode().Perm(); got != want {
+						t.Errorf("expected permissions %v for %s, got %v", want, file, got)
+					}
+				}
+			},
+		},
+		{
+			name: "entry escaping the local directory",
+			headers: []taro.Header{
+				{Name: "../evil.sh", Typeflag: taro.TypeReg, Mode: 0755},
+			},
+			wantErr: true,
+			check: func(t *testing.T, localPath string) {
+				if _, err := os.Stat(filepath.Join(filepath.Dir(localPath), "evil.sh")); !os.IsNotExist(err) {
+					t.Errorf("file should not have been extracted outside of the local directory")
+				}
+			},
+		},
+		{
+			name: "nested entry escaping the local directory",
+			headers: []taro.Header{
+				{Name: "logs/../../evil.sh", Typeflag: taro.TypeReg, Mode: 0755},
+			},
+			wantErr: true,
+		},
+		{
+			name: "absolute entry",
+			headers: []taro.Header{
+				{Name: "/etc/evil", Typeflag: taro.TypeReg, Mode: 0644},
+			},
+			wantErr: true,
+		},
+		{
+			name: "chained symbolic links escaping the local directory",
+			headers: []taro.Header{
+				{Name: "sub", Typeflag: taro.TypeSymlink, Linkname: "."},
+				{Name: "sub/x", Typeflag: taro.TypeSymlink, Linkname: ".."},
+				{Name: "x/pwned", Typeflag: taro.TypeReg, Mode: 0644},
+			},
+			wantErr: true,
+			check: func(t *testing.T, localPath string) {
+				if _, err := os.Stat(filepath.Join(filepath.Dir(localPath), "pwned")); !os.IsNotExist(err) {
+					t.Errorf("file should not have been extracted outside of the local directory")
+				}
+			},
+		},
+		{
+			name: "file replacing a symbolic link",
+			headers: []taro.Header{
+				{Name: "link", Typeflag: taro.TypeSymlink, Linkname: "target"},
+				{Name: "target", Typeflag: taro.TypeReg, Mode: 0644},
+				{Name: "link", Typeflag: taro.TypeReg, Mode: 0644},
+			},
+			check: func(t *testing.T, localPath string) {
+				info, err := os.Lstat(filepath.Join(localPath, "link"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !info.Mode().IsRegular() {
+					t.Errorf("expected the symbolic link to be replaced by a regular file, got mode %v", info.Mode())
+				}
+			},
+		},
+		{
+			name: "symbolic link pointing outside of the local directory",
+			headers: []taro.Header{
+				{Name: "passwd", Typeflag: taro.TypeSymlink, Linkname: "../../../etc/passwd"},
+			},
+			wantErr: true,
+			check: func(t *testing.T, localPath string) {
+				if _, err := os.Lstat(filepath.Join(localPath, "passwd")); !os.IsNotExist(err) {
+					t.Errorf("symbolic link should not have been created")
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the local path is a subdirectory, so that files escaping it are still in the temporary directory
+			localPath := filepath.Join(t.TempDir(), "local")
+			if err := os.Mkdir(localPath, 0755); err != nil {
+				t.Fatal(err)
+			}
+			err := untar(bytes.NewReader(makeArchive(t, tt.headers, "content")), localPath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("untar() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.check != nil {
+				tt.check(t, localPath)
+			}
+		})
+	}
+}
+
+func TestSyncClient_CopyFileFromPod(t *testing.T) {
+	compInfo := ComponentInfo{PodName: "pod", ContainerName: "runtime"}
+	archive := makeArchive(t, []taro.Header{{Name: "heap.dump", Typeflag: taro.TypeReg, Mode: 0644}}, "dump")
+
+	tests := []struct {
+		name         string
+		remotePath   string
+		stdout       []byte
+		stderr       string
+		execErr      error
+		wantCmd      []string
+		wantErr      bool
+		wantNotFound bool
+	}{
+		{
+			name:       "file copied",
+			remotePath: "/tmp/heap.dump",
+			stdout:     archive,
+			wantCmd:    []string{"tar", "cf", "-", "-C", "/tmp", "heap.dump"},
+		},
+		{
+			name:         "remote path not found",
+			remotePath:   "/tmp/missing",
+			stderr:       "tar: missing: Cannot stat: No such file or directory\ntar: Exiting with failure status due to previous errors\n",
+			execErr:      errors.New("command terminated with exit code 2"),
+			wantCmd:      []string{"tar", "cf", "-", "-C", "/tmp", "missing"},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		{
+			name:       "other error",
+			remotePath: "/tmp/heap.dump",
+			stderr:     "tar: command not found",
+			execErr:    errors.New("command terminated with exit code 127"),
+			wantCmd:    []string{"tar", "cf", "-", "-C", "/tmp", "heap.dump"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			platformClient := platform.NewMockClient(ctrl)
+			platformClient.EXPECT().ExecCMDInContainer(gomock.Any(), "runtime", "pod", gomock.Any(), gomock.Any(), gomock.Any(), nil, false).
+				DoAndReturn(func(_ context.Context, _, _ string, cmd []string, stdout, stderr io.Writer, _ io.Reader, _ bool) error {
+					if diff := cmp.Diff(tt.wantCmd, cmd); diff != "" {
+						t.Errorf("command mismatch (-want +got):\n%s", diff)
+					}
+					if _, err := stdout.Write(tt.stdout); err != nil {
+						return err
+					}
+					_, _ = stderr.Write([]byte(tt.stderr))
+					return tt.execErr
+				})
+
+			localPath := t.TempDir()
+			client := NewSyncClient(platformClient, nil)
+			err := client.CopyFileFromPod(context.Background(), compInfo, tt.remotePath, localPath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CopyFileFromPod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var notFoundErr *RemotePathNotFoundError
+			if errors.As(err, &notFoundErr) != tt.wantNotFound {
+				t.Errorf("expected RemotePathNotFoundError: %v, got %v", tt.wantNotFound, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			content, err := os.ReadFile(filepath.Join(localPath, "heap.dump"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != "dump" {
+				t.Errorf("expected content %q, got %q", "dump", string(content))
+			}
+		})
+	}
+}
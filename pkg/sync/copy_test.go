@@ -4,9 +4,13 @@ import (
 	taro "archive/tar"
 	"bytes"
 	"io"
+	"os"
 	"path"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/util"
@@ -130,7 +134,7 @@ func Test_linearTar(t *testing.T) {
 
 			go func() {
 				defer tarWriter.Close()
-				if err := linearTar(tt.args.srcBase, tt.args.srcFile, tt.args.destBase, tt.args.destFile, tarWriter, fs); (err != nil) != tt.wantErr {
+				if err := linearTar(tt.args.srcBase, tt.args.srcFile, tt.args.destBase, tt.args.destFile, false, tarWriter, fs); (err != nil) != tt.wantErr {
 					t.Errorf("linearTar() error = %v, wantErr %v", err, tt.wantErr)
 				}
 			}()
@@ -336,7 +340,7 @@ func Test_makeTar(t *testing.T) {
 			go func() {
 				defer tarWriter.Close()
 				wantErr := tt.wantErr
-				if err := makeTar(tt.args.srcPath, tt.args.destPath, writer, tt.args.files, tt.args.globExps, tt.args.ret, fs); (err != nil) != wantErr {
+				if err := makeTar(tt.args.srcPath, tt.args.destPath, writer, tt.args.files, tt.args.globExps, nil, tt.args.ret, fs); (err != nil) != wantErr {
 					t.Errorf("makeTar() error = %v, wantErr %v", err, tt.wantErr)
 					return
 				}
@@ -367,3 +371,369 @@ func Test_makeTar(t *testing.T) {
 		})
 	}
 }
+
+func Test_estimateTarSize(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+
+	dir0, err := fs.TempDir("", "dir0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, content := range map[string]string{
+		"red.js":                            "console.log('red')",
+		"README.txt":                        "",
+		filepath.Join("views", "view.html"): "<html>" + string(bytes.Repeat([]byte("a"), 1000)) + "</html>",
+	} {
+		err = fs.MkdirAll(filepath.Dir(filepath.Join(dir0, name)), 0755)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		err = fs.WriteFile(filepath.Join(dir0, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	err = fs.MkdirAll(filepath.Join(dir0, "empty"), 0755)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	files := []string{
+		filepath.Join(dir0, "red.js"),
+		filepath.Join(dir0, "red.js"),
+		filepath.Join(dir0, "README.txt"),
+		filepath.Join(dir0, "views"),
+		filepath.Join(dir0, "views", "view.html"),
+		filepath.Join(dir0, "empty"),
+		filepath.Join(dir0, "not-existing"),
+	}
+
+	tests := []struct {
+		name     string
+		globExps []string
+	}{
+		{
+			name: "all files",
+		},
+		{
+			name:     "with ignored files",
+			globExps: []string{"views"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := estimateTarSize(dir0, files, tt.globExps, fs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var buf bytes.Buffer
+			err = makeTar(dir0, filepath.Join("tmp", "dir1"), &buf, files, tt.globExps, nil, util.IndexerRet{}, fs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != int64(buf.Len()) {
+				t.Errorf("estimateTarSize() = %d, want %d", got, buf.Len())
+			}
+		})
+	}
+}
+
+func Test_progressWriter(t *testing.T) {
+	const (
+		chunkSize = 1024
+		nbChunks  = 100
+		total     = chunkSize * nbChunks
+	)
+
+	type report struct {
+		written int64
+		total   int64
+	}
+
+	tests := []struct {
+		name string
+		// tick is the simulated time elapsed between two writes
+		tick        time.Duration
+		wantReports int
+	}{
+		{
+			name:        "writes faster than the progress interval",
+			tick:        progressInterval / 10,
+			wantReports: nbChunks/10 + 1,
+		},
+		{
+			name:        "writes slower than the progress interval",
+			tick:        progressInterval,
+			wantReports: nbChunks + 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reports []report
+			reader, writer := io.Pipe()
+			pw := newProgressWriter(writer, total, func(written, total int64) {
+				reports = append(reports, report{written: written, total: total})
+			})
+			now := time.Now()
+			pw.now = func() time.Time {
+				now = now.Add(tt.tick)
+				return now
+			}
+
+			go func() {
+				defer writer.Close()
+				for i := 0; i < nbChunks; i++ {
+					if _, err := pw.Write(bytes.Repeat([]byte("a"), chunkSize)); err != nil {
+						t.Errorf("unexpected error: %v", err)
+						return
+					}
+				}
+				pw.done()
+			}()
+
+			received, err := io.Copy(io.Discard, reader)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if received != total {
+				t.Errorf("expected %d bytes to be written, got %d", total, received)
+			}
+
+			if len(reports) != tt.wantReports {
+				t.Errorf("expected %d calls to the progress function, got %d", tt.wantReports, len(reports))
+			}
+			var previous int64
+			for _, r := range reports {
+				if r.written < previous {
+					t.Errorf("written bytes should increase monotonically, got %d after %d", r.written, previous)
+				}
+				if r.total != total {
+					t.Errorf("expected total to be %d, got %d", total, r.total)
+				}
+				previous = r.written
+			}
+			if last := reports[len(reports)-1]; last.written != total {
+				t.Errorf("expected last report to be %d bytes, got %d", total, last.written)
+			}
+		})
+	}
+}
+
+func Test_makeTar_names(t *testing.T) {
+	tests := []struct {
+		name string
+		// files to create, relative to the source directory named "app"
+		files     []string
+		destPath  string
+		wantFiles map[string]bool
+		wantErr   bool
+	}{
+		{
+			name: "nested directories",
+			files: []string{
+				filepath.Join("src", "main", "java", "App.java"),
+				filepath.Join("src", "main", "resources", "app.properties"),
+			},
+			destPath: filepath.Join("projects", "app"),
+			wantFiles: map[string]bool{
+				"src/main/java/App.java":            true,
+				"src/main/resources/app.properties": true,
+			},
+		},
+		{
+			name: "names containing the destination directory name",
+			files: []string{
+				filepath.Join("app", "app.js"),
+				filepath.Join("projects", "app", "index.js"),
+			},
+			destPath: filepath.Join("projects", "app"),
+			wantFiles: map[string]bool{
+				"app/app.js":            true,
+				"projects/app/index.js": true,
+			},
+		},
+		{
+			name: "file outside of the source directory",
+			files: []string{
+				filepath.Join("..", "secret.txt"),
+			},
+			destPath: filepath.Join("projects", "app"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			tmpDir, err := fs.TempDir("", "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			srcPath := filepath.Join(tmpDir, "app")
+
+			var files []string
+			for _, f := range tt.files {
+				fullPath := filepath.Join(srcPath, f)
+				if err = fs.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if err = fs.WriteFile(fullPath, []byte(f), 0644); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				files = append(files, fullPath)
+			}
+
+			var buf bytes.Buffer
+			err = makeTar(srcPath, tt.destPath, &buf, files, nil, nil, util.IndexerRet{}, fs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("makeTar() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			gotFiles := make(map[string]bool)
+			tarReader := taro.NewReader(&buf)
+			for {
+				hdr, err := tarReader.Next()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				gotFiles[hdr.Name] = true
+			}
+			if !reflect.DeepEqual(gotFiles, tt.wantFiles) {
+				t.Errorf("expected files %v in tar, got %v", tt.wantFiles, gotFiles)
+			}
+		})
+	}
+}
+
+func Test_makeTar_symlink(t *testing.T) {
+	srcPath := t.TempDir()
+	target := filepath.Join(srcPath, "target.txt")
+	if err := os.WriteFile(target, []byte("content"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	link := filepath.Join(srcPath, "link.txt")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("unable to create symlink: %v", err)
+	}
+
+	var buf bytes.Buffer
+	err := makeTar(srcPath, filepath.Join("projects", "app"), &buf, []string{link}, nil, nil, util.IndexerRet{}, filesystem.DefaultFs{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tarReader := taro.NewReader(&buf)
+	hdr, err := tarReader.Next()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hdr.Name != "link.txt" {
+		t.Errorf("expected %q as file name, got %q", "link.txt", hdr.Name)
+	}
+	content, err := io.ReadAll(tarReader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != "content" {
+		t.Errorf("expected the content of the symlink target, got %q", string(content))
+	}
+}
+
+func Test_makeTar_executables(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	srcPath, err := fs.TempDir("", "app")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	perms := map[string]os.FileMode{
+		"mvnw":        0644,
+		"gradlew":     0600,
+		"run.sh":      0755,
+		"pom.xml":     0644,
+		"private.key": 0600,
+	}
+	var files []string
+	for name, perm := range perms {
+		if err = fs.WriteFile(filepath.Join(srcPath, name), []byte(name), perm); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		files = append(files, filepath.Join(srcPath, name))
+	}
+	executablePatterns := []string{"mvnw", "gradlew"}
+
+	t.Run("header modes", func(t *testing.T) {
+		wantModes := map[string]int64{
+			"mvnw":        0755,
+			"gradlew":     0711,
+			"run.sh":      0755,
+			"pom.xml":     0644,
+			"private.key": 0600,
+		}
+		var buf bytes.Buffer
+		err = makeTar(srcPath, filepath.Join("projects", "app"), &buf, files, nil, executablePatterns, util.IndexerRet{}, fs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		gotModes := make(map[string]int64)
+		tarReader := taro.NewReader(&buf)
+		for {
+			hdr, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			gotModes[hdr.Name] = hdr.Mode
+		}
+		if !reflect.DeepEqual(gotModes, wantModes) {
+			t.Errorf("expected modes %v, got %v", wantModes, gotModes)
+		}
+	})
+
+	t.Run("executable files", func(t *testing.T) {
+		got, err := getExecutableFiles(srcPath, files, nil, executablePatterns, util.IndexerRet{}, fs)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		sort.Strings(got)
+		want := []string{"gradlew", "mvnw", "run.sh"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected executable files %v, got %v", want, got)
+		}
+	})
+}
+
+func Test_getCmdToMakeExecutable(t *testing.T) {
+	tests := []struct {
+		name        string
+		executables []string
+		targetPath  string
+		want        []string
+	}{
+		{
+			name:        "single file",
+			executables: []string{"mvnw"},
+			targetPath:  "/projects",
+			want:        []string{"chmod", "+x", "/projects/mvnw"},
+		},
+		{
+			name:        "files in sub-directories",
+			executables: []string{"mvnw", "scripts/build.sh", "web/gradlew"},
+			targetPath:  "/projects/app",
+			want:        []string{"chmod", "+x", "/projects/app/mvnw", "/projects/app/scripts/build.sh", "/projects/app/web/gradlew"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getCmdToMakeExecutable(tt.executables, tt.targetPath)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getCmdToMakeExecutable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
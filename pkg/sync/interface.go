@@ -15,18 +15,26 @@ type ComponentInfo struct {
 
 type SyncExtracter func(ComponentInfo, string, io.Reader) error
 
+// ProgressFunc is called while files are copied to a component,
+// with the number of bytes already written and the estimated total number of bytes to write
+type ProgressFunc func(written, total int64)
+
 // SyncParameters is a struct containing the parameters to be used when syncing a devfile component
 type SyncParameters struct {
 	Path                     string   // Path refers to the parent folder containing the source code to push up to a component
 	WatchFiles               []string // Optional: WatchFiles is the list of changed files detected by odo watch. If empty or nil, odo will check .odo/odo-file-index.json to determine changed files
 	WatchDeletedFiles        []string // Optional: WatchDeletedFiles is the list of deleted files detected by odo watch. If empty or nil, odo will check .odo/odo-file-index.json to determine deleted files
 	IgnoredFiles             []string // IgnoredFiles is the list of files to not push up to a component
+	ExecutablePatterns       []string // Optional: ExecutablePatterns is the list of gitignore-like rules matching files to make executable in the component
 	DevfileScanIndexForWatch bool     // DevfileScanIndexForWatch is true if watch's push should regenerate the index file during SyncFiles, false otherwise. See 'pkg/sync/adapter.go' for details
 	ForcePush                bool
 	CompInfo                 ComponentInfo
 	Files                    map[string]string
+	Progress                 ProgressFunc // Optional: Progress is called regularly while files are copied to the component
 }
 
 type Client interface {
 	SyncFiles(ctx context.Context, syncParameters SyncParameters) (bool, error)
+	// CopyFileFromPod copies the file or directory remotePath from the container of the component into the localPath directory
+	CopyFileFromPod(ctx context.Context, compInfo ComponentInfo, remotePath, localPath string) error
 }
@@ -34,6 +34,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// CopyFileFromPod mocks base method.
+func (m *MockClient) CopyFileFromPod(ctx context.Context, compInfo ComponentInfo, remotePath, localPath string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyFileFromPod", ctx, compInfo, remotePath, localPath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyFileFromPod indicates an expected call of CopyFileFromPod.
+func (mr *MockClientMockRecorder) CopyFileFromPod(ctx, compInfo, remotePath, localPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyFileFromPod", reflect.TypeOf((*MockClient)(nil).CopyFileFromPod), ctx, compInfo, remotePath, localPath)
+}
+
 // SyncFiles mocks base method.
 func (m *MockClient) SyncFiles(ctx context.Context, syncParameters SyncParameters) (bool, error) {
 	m.ctrl.T.Helper()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -148,7 +149,7 @@ func (a SyncClient) SyncFiles(ctx context.Context, syncParameters SyncParameters
 		}
 	}
 
-	err := a.pushLocal(ctx, syncParameters.Path, changedFiles, deletedFiles, syncParameters.ForcePush, syncParameters.IgnoredFiles, syncParameters.CompInfo, ret)
+	err := a.pushLocal(ctx, syncParameters.Path, changedFiles, deletedFiles, syncParameters.ForcePush, syncParameters.IgnoredFiles, syncParameters.ExecutablePatterns, syncParameters.CompInfo, ret, syncParameters.Progress)
 	if err != nil {
 		return false, fmt.Errorf("failed to sync to component with name %s: %w", syncParameters.CompInfo.ComponentName, err)
 	}
@@ -189,7 +190,7 @@ func filterIgnores(path string, filesChanged, filesDeleted, absIgnoreRules []str
 }
 
 // pushLocal syncs source code from the user's disk to the component
-func (a SyncClient) pushLocal(ctx context.Context, path string, files []string, delFiles []string, isForcePush bool, globExps []string, compInfo ComponentInfo, ret util.IndexerRet) error {
+func (a SyncClient) pushLocal(ctx context.Context, path string, files []string, delFiles []string, isForcePush bool, globExps []string, executablePatterns []string, compInfo ComponentInfo, ret util.IndexerRet, progress ProgressFunc) error {
 	klog.V(4).Infof("Push: componentName: %s, path: %s, files: %s, delFiles: %s, isForcePush: %+v", compInfo.ComponentName, path, files, delFiles, isForcePush)
 
 	// Edge case: check to see that the path is NOT empty.
@@ -215,7 +216,10 @@ func (a SyncClient) pushLocal(ctx context.Context, path string, files []string,
 	}
 	// If there were any files deleted locally, delete them remotely too.
 	if len(delFiles) > 0 {
-		cmdArr := getCmdToDeleteFiles(delFiles, syncFolder)
+		cmdArr, err := getCmdToDeleteFiles(delFiles, syncFolder)
+		if err != nil {
+			return err
+		}
 
 		_, _, err = a.execClient.ExecuteCommand(ctx, cmdArr, compInfo.PodName, compInfo.ContainerName, false, nil, nil)
 		if err != nil {
@@ -231,7 +235,7 @@ func (a SyncClient) pushLocal(ctx context.Context, path string, files []string,
 
 	if isForcePush || len(files) > 0 {
 		klog.V(4).Infof("Copying files %s to pod", strings.Join(files, " "))
-		err = a.CopyFile(ctx, path, compInfo, syncFolder, files, globExps, ret)
+		err = a.CopyFile(ctx, path, compInfo, syncFolder, files, globExps, executablePatterns, ret, progress)
 		if err != nil {
 			return fmt.Errorf("unable push files to pod: %w", err)
 		}
@@ -305,7 +309,13 @@ func getCmdToCreateSyncFolder(syncFolder string) []string {
 }
 
 // getCmdToDeleteFiles returns the command used to delete the remote files on the container that are marked for deletion
-func getCmdToDeleteFiles(delFiles []string, syncFolder string) []string {
+// An error is returned if any of the files is not located in the sync folder
+func getCmdToDeleteFiles(delFiles []string, syncFolder string) ([]string, error) {
+	for _, delFile := range delFiles {
+		if err := validateRemoteFileForDeletion(delFile, syncFolder); err != nil {
+			return nil, err
+		}
+	}
 	rmPaths := dfutil.GetRemoteFilesMarkedForDeletion(delFiles, syncFolder)
 	klog.V(4).Infof("remote files marked for deletion are %+v", rmPaths)
 	cmdArr := []string{"rm", "-rf"}
@@ -313,5 +323,18 @@ func getCmdToDeleteFiles(delFiles []string, syncFolder string) []string {
 	for _, remote := range rmPaths {
 		cmdArr = append(cmdArr, filepath.ToSlash(remote))
 	}
-	return cmdArr
+	return cmdArr, nil
+}
+
+// validateRemoteFileForDeletion checks that delFile, relative to syncFolder, designates a file located in syncFolder
+func validateRemoteFileForDeletion(delFile string, syncFolder string) error {
+	slashed := filepath.ToSlash(delFile)
+	if delFile == "" || path.IsAbs(slashed) || filepath.IsAbs(delFile) {
+		return fmt.Errorf("unable to delete file %q in the container: path must be relative to %q", delFile, syncFolder)
+	}
+	cleaned := path.Clean(slashed)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return fmt.Errorf("unable to delete file %q in the container: path must be located in %q", delFile, syncFolder)
+	}
+	return nil
 }
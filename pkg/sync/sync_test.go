@@ -52,6 +52,7 @@ func TestGetCmdToDeleteFiles(t *testing.T) {
 		delFiles   []string
 		syncFolder string
 		want       []string
+		wantErr    bool
 	}{
 		{
 			name:       "Case 1: One deleted file",
@@ -71,12 +72,53 @@ func TestGetCmdToDeleteFiles(t *testing.T) {
 			syncFolder: syncFolder,
 			want:       []string{"rm", "-rf", syncFolder + "/test.txt", syncFolder + "/hello.c"},
 		},
+		{
+			name:       "Case 3: Deleted file in a sub-directory",
+			delFiles:   []string{filepath.Join("src", "..", "lib", "hello.c")},
+			syncFolder: syncFolder,
+			want:       []string{"rm", "-rf", syncFolder + "/lib/hello.c"},
+		},
+		{
+			name:       "Case 4: Deleted file outside of the sync folder",
+			delFiles:   []string{"test.txt", filepath.Join("..", "hello.c")},
+			syncFolder: syncFolder,
+			wantErr:    true,
+		},
+		{
+			name:       "Case 5: Deleted file escaping the sync folder after a sub-directory",
+			delFiles:   []string{filepath.Join("src", "..", "..", "hello.c")},
+			syncFolder: syncFolder,
+			wantErr:    true,
+		},
+		{
+			name:       "Case 6: Absolute path",
+			delFiles:   []string{"/etc/passwd"},
+			syncFolder: syncFolder,
+			wantErr:    true,
+		},
+		{
+			name:       "Case 7: Sync folder itself",
+			delFiles:   []string{"."},
+			syncFolder: syncFolder,
+			wantErr:    true,
+		},
+		{
+			name:       "Case 8: Empty path",
+			delFiles:   []string{""},
+			syncFolder: syncFolder,
+			wantErr:    true,
+		},
 	}
 	for _, tt := range tests {
-		cmdArr := getCmdToDeleteFiles(tt.delFiles, tt.syncFolder)
-		if diff := cmp.Diff(tt.want, cmdArr); diff != "" {
-			t.Errorf("getCmdToDeleteFiles() mismatch (-want +got):\n%s", diff)
-		}
+		t.Run(tt.name, func(t *testing.T) {
+			cmdArr, err := getCmdToDeleteFiles(tt.delFiles, tt.syncFolder)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getCmdToDeleteFiles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, cmdArr); diff != "" {
+				t.Errorf("getCmdToDeleteFiles() mismatch (-want +got):\n%s", diff)
+			}
+		})
 	}
 }
 
@@ -266,7 +308,7 @@ func TestPushLocal(t *testing.T) {
 			client:      syncClient,
 			path:        directory,
 			files:       []string{},
-			delFiles:    []string{path.Join(directory, "test.log")},
+			delFiles:    []string{"test.log"},
 			isForcePush: false,
 			compInfo: ComponentInfo{
 				ContainerName: "abcd",
@@ -304,7 +346,7 @@ func TestPushLocal(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			execClient := exec.NewExecClient(kc)
 			syncAdapter := NewSyncClient(kc, execClient)
-			err := syncAdapter.pushLocal(context.Background(), tt.path, tt.files, tt.delFiles, tt.isForcePush, []string{}, tt.compInfo, util.IndexerRet{})
+			err := syncAdapter.pushLocal(context.Background(), tt.path, tt.files, tt.delFiles, tt.isForcePush, []string{}, nil, tt.compInfo, util.IndexerRet{}, nil)
 			if !tt.wantErr && err != nil {
 				t.Errorf("TestPushLocal error: error pushing files: %v", err)
 			}
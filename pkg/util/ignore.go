@@ -0,0 +1,179 @@
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	gitignore "github.com/sabhiram/go-gitignore"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// DotOdoIgnoreFile is the file defining the files ignored by the sync, instead of the .gitignore file
+const DotOdoIgnoreFile = ".odoignore"
+
+// defaultIgnoreRules are the rules written to a new .odoignore file, for all component types
+var defaultIgnoreRules = []string{".git", DotOdoDirectory}
+
+// defaultIgnoreRulesByType are the rules written to a new .odoignore file, depending on the type of the component
+var defaultIgnoreRulesByType = map[string][]string{
+	"nodejs":     {"node_modules"},
+	"javascript": {"node_modules"},
+	"typescript": {"node_modules"},
+	"java":       {"target"},
+	"python":     {"__pycache__", "*.pyc"},
+	"dotnet":     {"bin", "obj"},
+}
+
+// GetDefaultIgnoreRules returns the rules written to a new .odoignore file for the component type (nodejs, java, etc).
+// The type is case-insensitive, and unknown types get only the rules common to all components
+func GetDefaultIgnoreRules(componentType string) []string {
+	rules := make([]string, 0, len(defaultIgnoreRules))
+	rules = append(rules, defaultIgnoreRules...)
+	return append(rules, defaultIgnoreRulesByType[strings.ToLower(componentType)]...)
+}
+
+// CreateDefaultIgnoreFile creates a .odoignore file in the directory containing the default rules for the component type,
+// only if neither a .odoignore nor a .gitignore file exists in the directory, as a new .odoignore file would take
+// precedence over the rules of the .gitignore file
+func CreateDefaultIgnoreFile(componentType, directory string) error {
+	return createDefaultIgnoreFile(componentType, directory, filesystem.DefaultFs{})
+}
+
+func createDefaultIgnoreFile(componentType, directory string, fs filesystem.Filesystem) error {
+	for _, name := range []string{DotOdoIgnoreFile, DotGitIgnoreFile} {
+		_, err := fs.Stat(filepath.Join(directory, name))
+		if err == nil {
+			return nil
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	content := strings.Join(GetDefaultIgnoreRules(componentType), "\n") + "\n"
+	err := fs.WriteFile(filepath.Join(directory, DotOdoIgnoreFile), []byte(content), 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create %s file: %w", DotOdoIgnoreFile, err)
+	}
+	return nil
+}
+
+// ParseIgnoreFile returns the rules defined in the ignore file, with the .gitignore syntax.
+// Blank lines and comments are skipped, trailing spaces are removed, and negation patterns (!pattern) are kept
+// as they are. A leading \# is unescaped into #, to match files starting with a hash.
+func ParseIgnoreFile(path string) ([]string, error) {
+	return parseIgnoreFile(path, filesystem.DefaultFs{})
+}
+
+func parseIgnoreFile(path string, fs filesystem.Filesystem) ([]string, error) {
+	content, err := fs.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, `\#`) {
+			line = line[1:]
+		}
+		rules = append(rules, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// GetIgnoreRulesFromDirectory returns the rules defined in the .odoignore file of the directory, or, if this file
+// does not exist, in the .gitignore file. The .git directory is always ignored.
+func GetIgnoreRulesFromDirectory(directory string) ([]string, error) {
+	return getIgnoreRulesFromDirectory(directory, filesystem.DefaultFs{})
+}
+
+func getIgnoreRulesFromDirectory(directory string, fs filesystem.Filesystem) ([]string, error) {
+	rules := []string{".git"}
+	for _, name := range []string{DotOdoIgnoreFile, DotGitIgnoreFile} {
+		fileRules, err := parseIgnoreFile(filepath.Join(directory, name), fs)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, rule := range fileRules {
+			if rule != ".git" {
+				rules = append(rules, rule)
+			}
+		}
+		break
+	}
+	return rules, nil
+}
+
+// ValidatePatterns returns a warning for each pattern matching no file in the directory,
+// as such patterns are often typos. The directory is walked only until all the patterns have matched a file
+func ValidatePatterns(directory string, patterns []string) ([]string, error) {
+	return validatePatterns(directory, patterns, filesystem.DefaultFs{})
+}
+
+// errAllPatternsMatched stops the walk of the directory once all the patterns have matched a file
+var errAllPatternsMatched = errors.New("all patterns matched")
+
+func validatePatterns(directory string, patterns []string, fs filesystem.Filesystem) ([]string, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	matchers := make([]*gitignore.GitIgnore, len(patterns))
+	for i, pattern := range patterns {
+		// a negation pattern is useful only if the negated pattern matches some files
+		matchers[i] = gitignore.CompileIgnoreLines(strings.TrimPrefix(pattern, "!"))
+	}
+	found := make([]bool, len(patterns))
+	remaining := len(patterns)
+
+	err := fs.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == directory {
+			return nil
+		}
+		rel, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		for i, matcher := range matchers {
+			if !found[i] && matcher.MatchesPath(rel) {
+				found[i] = true
+				remaining--
+			}
+		}
+		if remaining == 0 {
+			return errAllPatternsMatched
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errAllPatternsMatched) {
+		return nil, err
+	}
+
+	var warnings []string
+	for i, pattern := range patterns {
+		if !found[i] {
+			warnings = append(warnings, fmt.Sprintf("pattern %q does not match any file in %s", pattern, directory))
+		}
+	}
+	return warnings, nil
+}
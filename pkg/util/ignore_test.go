@@ -0,0 +1,191 @@
+package util
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestCreateDefaultIgnoreFile(t *testing.T) {
+	tests := []struct {
+		name          string
+		componentType string
+		existingFiles map[string]string
+		want          string
+	}{
+		{
+			name:          "nodejs component",
+			componentType: "nodejs",
+			want:          ".git\n.odo\nnode_modules\n",
+		},
+		{
+			name:          "java component, type is case-insensitive",
+			componentType: "Java",
+			want:          ".git\n.odo\ntarget\n",
+		},
+		{
+			name:          "unknown component type",
+			componentType: "cobol",
+			want:          ".git\n.odo\n",
+		},
+		{
+			name:          "existing .odoignore file is not modified",
+			componentType: "nodejs",
+			existingFiles: map[string]string{DotOdoIgnoreFile: "dist\n"},
+			want:          "dist\n",
+		},
+		{
+			name:          "no .odoignore file is created if a .gitignore file exists",
+			componentType: "nodejs",
+			existingFiles: map[string]string{DotGitIgnoreFile: "dist\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			dir := "/project"
+			if err := fs.MkdirAll(dir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			for name, content := range tt.existingFiles {
+				if err := fs.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := createDefaultIgnoreFile(tt.componentType, dir, fs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := fs.ReadFile(filepath.Join(dir, DotOdoIgnoreFile))
+			if tt.want == "" {
+				if err == nil {
+					t.Errorf("expected no .odoignore file, got:\n%s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected content %q, got %q", tt.want, string(got))
+			}
+		})
+	}
+}
+
+func TestParseIgnoreFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "comments and blank lines are skipped",
+			content: "# dependencies\nnode_modules\n\n   \n# build\ndist\n",
+			want:    []string{"node_modules", "dist"},
+		},
+		{
+			name:    "trailing spaces and CRLF line endings are removed",
+			content: "node_modules  \r\ndist\t\r\n",
+			want:    []string{"node_modules", "dist"},
+		},
+		{
+			name:    "negation patterns are kept",
+			content: "*.log\n!important.log\n",
+			want:    []string{"*.log", "!important.log"},
+		},
+		{
+			name:    "escaped hash is not a comment",
+			content: "\\#notes.txt\n",
+			want:    []string{"#notes.txt"},
+		},
+		{
+			name:    "patterns starting with .git are kept",
+			content: ".github\n.gitlab-ci.yml\n",
+			want:    []string{".github", ".gitlab-ci.yml"},
+		},
+		{
+			name:    "empty file",
+			content: "",
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			path := "/project/.odoignore"
+			if err := fs.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := parseIgnoreFile(path, fs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseIgnoreFile() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := parseIgnoreFile("/project/.odoignore", filesystem.NewFakeFs())
+		if err == nil {
+			t.Errorf("expected an error for a missing file")
+		}
+	})
+}
+
+func TestGetIgnoreRulesFromDirectory_odoignorePrecedence(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	dir := "/project"
+	for name, content := range map[string]string{
+		DotGitIgnoreFile: "node_modules\n",
+		DotOdoIgnoreFile: ".git\ndist\n",
+	} {
+		if err := fs.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := getIgnoreRulesFromDirectory(dir, fs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{".git", "dist"}, got); diff != "" {
+		t.Errorf("getIgnoreRulesFromDirectory() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidatePatterns(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	dir := "/project"
+	for _, file := range []string{"main.js", "node_modules/express/index.js", "logs/app.log"} {
+		if err := fs.WriteFile(filepath.Join(dir, file), []byte{}, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := validatePatterns(dir, []string{"node_modules", "*.log", "!app.log", "node_module", "dist/"}, fs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		`pattern "node_module" does not match any file in /project`,
+		`pattern "dist/" does not match any file in /project`,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("validatePatterns() mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err = validatePatterns(dir, []string{"main.js", "logs/"}, fs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no warning when all the patterns match, got %v", got)
+	}
+}
@@ -1,6 +1,10 @@
 package vars
 
-import "fmt"
+import (
+	"fmt"
+
+	odoerrors "github.com/redhat-developer/odo/pkg/errors"
+)
 
 type ErrBadKey struct {
 	msg string
@@ -13,3 +17,7 @@ func NewErrBadKey(msg string) ErrBadKey {
 func (e ErrBadKey) Error() string {
 	return fmt.Sprintf("poorly formatted environment: %s", e.msg)
 }
+
+func (e ErrBadKey) Kind() odoerrors.Kind {
+	return odoerrors.KindValidationFailed
+}
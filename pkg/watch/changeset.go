@@ -0,0 +1,47 @@
+package watch
+
+import (
+	"sort"
+)
+
+// changeSet collects the files changed and deleted while the sync of files is paused.
+// A path is either changed or deleted, depending on the latest changes added for it.
+// When a path is both changed and deleted in the same changes, the deletion overrides the modification.
+type changeSet struct {
+	// changes is true for deleted paths, false for changed files
+	changes map[string]bool
+}
+
+func (o *changeSet) add(changedFiles, deletedPaths []string) {
+	if o.changes == nil {
+		o.changes = make(map[string]bool, len(changedFiles)+len(deletedPaths))
+	}
+	for _, file := range changedFiles {
+		o.changes[file] = false
+	}
+	for _, path := range deletedPaths {
+		o.changes[path] = true
+	}
+}
+
+// files returns the sorted changed files and deleted paths
+func (o *changeSet) files() (changedFiles, deletedPaths []string) {
+	for path, deleted := range o.changes {
+		if deleted {
+			deletedPaths = append(deletedPaths, path)
+		} else {
+			changedFiles = append(changedFiles, path)
+		}
+	}
+	sort.Strings(changedFiles)
+	sort.Strings(deletedPaths)
+	return changedFiles, deletedPaths
+}
+
+func (o *changeSet) len() int {
+	return len(o.changes)
+}
+
+func (o *changeSet) reset() {
+	o.changes = nil
+}
@@ -0,0 +1,71 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_changeSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		batches     [][2][]string
+		wantChanged []string
+		wantDeleted []string
+	}{
+		{
+			name: "changes are deduplicated",
+			batches: [][2][]string{
+				{{"b", "a"}, nil},
+				{{"a"}, nil},
+			},
+			wantChanged: []string{"a", "b"},
+		},
+		{
+			name: "deletion overrides modification in the same batch",
+			batches: [][2][]string{
+				{{"a", "b"}, {"a"}},
+			},
+			wantChanged: []string{"b"},
+			wantDeleted: []string{"a"},
+		},
+		{
+			name: "deletion overrides previous modification",
+			batches: [][2][]string{
+				{{"a"}, nil},
+				{nil, {"a"}},
+			},
+			wantDeleted: []string{"a"},
+		},
+		{
+			name: "modification after deletion is a change",
+			batches: [][2][]string{
+				{nil, {"a"}},
+				{{"a"}, nil},
+			},
+			wantChanged: []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var changes changeSet
+			for _, batch := range tt.batches {
+				changes.add(batch[0], batch[1])
+			}
+			gotChanged, gotDeleted := changes.files()
+			if diff := cmp.Diff(tt.wantChanged, gotChanged); diff != "" {
+				t.Errorf("changeSet.files() changed mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantDeleted, gotDeleted); diff != "" {
+				t.Errorf("changeSet.files() deleted mismatch (-want +got):\n%s", diff)
+			}
+			if changes.len() != len(tt.wantChanged)+len(tt.wantDeleted) {
+				t.Errorf("expected len %d, got %d", len(tt.wantChanged)+len(tt.wantDeleted), changes.len())
+			}
+			changes.reset()
+			if changes.len() != 0 {
+				t.Errorf("expected no change after reset, got %d", changes.len())
+			}
+		})
+	}
+}
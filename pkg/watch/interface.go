@@ -11,4 +11,13 @@ type Client interface {
 	// parts of code (unfortunately, tthere is no place to store the status of the component in some Kubernetes resource
 	// as it is generally done for a Kubernetes resource)
 	WatchAndPush(ctx context.Context, parameters WatchParameters, componentStatus ComponentStatus) error
+	// Pause pauses the sync of files. The changes detected while the sync is paused are accumulated,
+	// and synced at once when Resume is called
+	Pause()
+	// Resume resumes the sync of files, and syncs the changes detected while the sync was paused
+	Resume()
+	// IsPaused returns true if the sync of files is paused
+	IsPaused() bool
+	// PendingChanges returns the number of changed files and deleted paths which have not been synced yet
+	PendingChanges() int
 }
@@ -34,6 +34,58 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// IsPaused mocks base method.
+func (m *MockClient) IsPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPaused indicates an expected call of IsPaused.
+func (mr *MockClientMockRecorder) IsPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPaused", reflect.TypeOf((*MockClient)(nil).IsPaused))
+}
+
+// Pause mocks base method.
+func (m *MockClient) Pause() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Pause")
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockClientMockRecorder) Pause() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockClient)(nil).Pause))
+}
+
+// PendingChanges mocks base method.
+func (m *MockClient) PendingChanges() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingChanges")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// PendingChanges indicates an expected call of PendingChanges.
+func (mr *MockClientMockRecorder) PendingChanges() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingChanges", reflect.TypeOf((*MockClient)(nil).PendingChanges))
+}
+
+// Resume mocks base method.
+func (m *MockClient) Resume() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Resume")
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockClientMockRecorder) Resume() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockClient)(nil).Resume))
+}
+
 // WatchAndPush mocks base method.
 func (m *MockClient) WatchAndPush(ctx context.Context, parameters WatchParameters, componentStatus ComponentStatus) error {
 	m.ctrl.T.Helper()
@@ -28,6 +28,10 @@ type ComponentStatus struct {
 	// ImageComponentsAutoApplied is a cache of all image components that have been auto-applied.
 	// This map allows to avoid applying them too many times upon state changes in the cluster for example.
 	ImageComponentsAutoApplied map[string]v1alpha2.ImageComponent
+	// SyncPaused is true when the sync of files is paused
+	SyncPaused bool
+	// PendingChanges is the number of changes detected and not synced yet, because the sync is paused or the last sync failed
+	PendingChanges int
 }
 
 func (o *ComponentStatus) SetState(s State) {
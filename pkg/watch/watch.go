@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/redhat-developer/odo/pkg/dev"
@@ -18,6 +19,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 
 	"github.com/fsnotify/fsnotify"
@@ -34,6 +36,13 @@ import (
 const (
 	// PushErrorString is the string that is printed when an error occurs during watch's Push operation
 	PushErrorString = "Error occurred on Push"
+
+	// syncStep is the name of the step reported when the changes are pushed to the component
+	syncStep = "Pushing changes"
+
+	// defaultMaxBatchSize is the number of pending changes after which the files are synced even if the sync is paused,
+	// when WatchParameters.MaxBatchSize is not set
+	defaultMaxBatchSize = 1000
 )
 
 type WatchClient struct {
@@ -53,6 +62,15 @@ type WatchClient struct {
 	// deploymentGeneration indicates the generation of the latest observed Deployment
 	deploymentGeneration int64
 	readyReplicas        int32
+
+	// syncMu protects paused and pendingChanges, which can be accessed by Pause and Resume from other goroutines
+	syncMu sync.Mutex
+	// paused is true when the sync of files is paused
+	paused bool
+	// pendingChanges are the changes not synced yet, because the sync is paused or the last sync failed
+	pendingChanges changeSet
+	// syncStateCh receives a value when the sync of files is paused or resumed
+	syncStateCh chan struct{}
 }
 
 var _ Client = (*WatchClient)(nil)
@@ -64,7 +82,55 @@ func NewWatchClient(
 	return &WatchClient{
 		kubeClient:     kubeClient,
 		informerClient: informerClient,
+		syncStateCh:    make(chan struct{}, 1),
+	}
+}
+
+// Pause pauses the sync of files. The changes detected while the sync is paused are accumulated,
+// and synced at once when Resume is called, or when their number exceeds WatchParameters.MaxBatchSize
+func (o *WatchClient) Pause() {
+	o.syncMu.Lock()
+	defer o.syncMu.Unlock()
+	if o.paused {
+		return
+	}
+	o.paused = true
+	o.notifySyncState()
+}
+
+// Resume resumes the sync of files, and syncs the changes detected while the sync was paused
+func (o *WatchClient) Resume() {
+	o.syncMu.Lock()
+	defer o.syncMu.Unlock()
+	if !o.paused {
+		return
 	}
+	o.paused = false
+	o.notifySyncState()
+}
+
+// notifySyncState notifies the event loop that the sync of files has been paused or resumed.
+// It must be called with syncMu held
+func (o *WatchClient) notifySyncState() {
+	select {
+	case o.syncStateCh <- struct{}{}:
+	default:
+		// a notification is already pending, the event loop will read the latest state
+	}
+}
+
+// IsPaused returns true if the sync of files is paused
+func (o *WatchClient) IsPaused() bool {
+	o.syncMu.Lock()
+	defer o.syncMu.Unlock()
+	return o.paused
+}
+
+// PendingChanges returns the number of changed files and deleted paths which have not been synced yet
+func (o *WatchClient) PendingChanges() int {
+	o.syncMu.Lock()
+	defer o.syncMu.Unlock()
+	return o.pendingChanges.len()
 }
 
 // WatchParameters is designed to hold the controllables and attributes that the watch function works on
@@ -82,6 +148,10 @@ type WatchParameters struct {
 
 	// WatchCluster indicates to watch Cluster-related objects (Deployment, Pod, etc)
 	WatchCluster bool
+
+	// MaxBatchSize is the number of pending changes after which the files are synced, even if the sync is paused
+	// (ODO_WATCH_MAX_BATCH_SIZE). defaultMaxBatchSize is used if not set
+	MaxBatchSize int
 }
 
 // evaluateChangesFunc evaluates any file changes for the events by ignoring the files in fileIgnores slice and removes
@@ -193,6 +263,7 @@ func (o *WatchClient) eventWatcher(
 		componentName = odocontext.GetComponentName(ctx)
 		appName       = odocontext.GetApplication(ctx)
 		out           = parameters.StartOptions.Out
+		reporter      = eventReporter(parameters)
 	)
 
 	var events []fsnotify.Event
@@ -234,8 +305,15 @@ func (o *WatchClient) eventWatcher(
 			if !o.forceSync {
 				// first find the files that have changed (also includes the ones newly created) or deleted
 				changedFiles, deletedPaths = evaluateChangesHandler(events, path, parameters.StartOptions.IgnorePaths, o.sourcesWatcher)
+				var doSync bool
+				changedFiles, deletedPaths, doSync = o.collectPendingChanges(out, parameters.MaxBatchSize, changedFiles, deletedPaths)
+				componentStatus.PendingChanges = o.PendingChanges()
+				if componentStatus.PendingChanges > 0 {
+					// the changes are kept in the pending changes, the events do not need to be evaluated again
+					events = []fsnotify.Event{}
+				}
 				// process the changes and sync files with remote pod
-				if len(changedFiles) == 0 && len(deletedPaths) == 0 {
+				if !doSync || len(changedFiles) == 0 && len(deletedPaths) == 0 {
 					continue
 				}
 			}
@@ -250,21 +328,45 @@ func (o *WatchClient) eventWatcher(
 			// empty the events to receive new events
 			if componentStatus.GetState() == StateReady {
 				events = []fsnotify.Event{} // empty the events slice to capture new events
+				o.syncMu.Lock()
+				o.pendingChanges.reset()
+				o.syncMu.Unlock()
+				componentStatus.PendingChanges = 0
 			}
 
 		case watchErr := <-o.sourcesWatcher.Errors:
 			return watchErr
 
 		case key := <-o.keyWatcher:
-			if key == 'p' {
+			switch key {
+			case 'p':
 				o.forceSync = true
 				sourcesTimer.Reset(100 * time.Millisecond)
+			case 's':
+				if o.IsPaused() {
+					o.Resume()
+				} else {
+					o.Pause()
+				}
 			}
 
 		case <-parameters.StartOptions.PushWatcher:
 			o.forceSync = true
 			sourcesTimer.Reset(100 * time.Millisecond)
 
+		case <-o.syncStateCh:
+			componentStatus.SyncPaused = o.IsPaused()
+			componentStatus.PendingChanges = o.PendingChanges()
+			if componentStatus.SyncPaused {
+				fmt.Fprintf(out, "Sync of local changes paused\n\n")
+				reporter.SyncPaused(componentStatus.PendingChanges)
+				continue
+			}
+			fmt.Fprintf(out, "Sync of local changes resumed\n\n")
+			reporter.SyncResumed(componentStatus.PendingChanges)
+			// sync the changes detected while the sync was paused
+			sourcesTimer.Reset(100 * time.Millisecond)
+
 		case ev := <-o.deploymentWatcher.ResultChan():
 			switch obj := ev.Object.(type) {
 			case *appsv1.Deployment:
@@ -336,6 +438,33 @@ func (o *WatchClient) eventWatcher(
 	}
 }
 
+// collectPendingChanges adds the changes to the pending changes, if the sync is paused or if some changes are already pending.
+// It returns the changes to sync, and false if the files must not be synced because the sync is paused.
+// If the number of pending changes exceeds maxBatchSize, a warning is displayed and the changes are synced, even if the sync is paused.
+func (o *WatchClient) collectPendingChanges(out io.Writer, maxBatchSize int, changedFiles, deletedPaths []string) ([]string, []string, bool) {
+	o.syncMu.Lock()
+	defer o.syncMu.Unlock()
+
+	if !o.paused && o.pendingChanges.len() == 0 {
+		return changedFiles, deletedPaths, true
+	}
+	o.pendingChanges.add(changedFiles, deletedPaths)
+
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+	pending := o.pendingChanges.len()
+	if o.paused {
+		if pending <= maxBatchSize {
+			klog.V(4).Infof("sync is paused, %d changes pending", pending)
+			return nil, nil, false
+		}
+		log.Fwarningf(out, "Sync is paused, but %d changes are pending (maximum %d), pushing them", pending, maxBatchSize)
+	}
+	changedFiles, deletedPaths = o.pendingChanges.files()
+	return changedFiles, deletedPaths, true
+}
+
 // evaluateFileChanges evaluates any file changes for the events. It ignores the files in fileIgnores slice related to path, and removes
 // any deleted paths from the watcher
 func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
@@ -428,9 +557,13 @@ func (o *WatchClient) processEvents(
 		WatchDeletedFiles:        deletedPaths,
 		DevfileScanIndexForWatch: !hasFirstSuccessfulPushOccurred,
 	}
+	reporter := eventReporter(parameters)
+	reporter.StepStarted(syncStep)
+
 	oldStatus := *componentStatus
 	err := parameters.DevfileWatchHandler(ctx, pushParams, componentStatus)
 	if err != nil {
+		reporter.StepFailed(syncStep, err)
 		if isFatal(err) {
 			return err
 		}
@@ -452,6 +585,8 @@ func (o *WatchClient) processEvents(
 		}
 		return nil
 	}
+	reporter.FilesSynced(changedFiles, deletedPaths)
+	reporter.StepSucceeded(syncStep)
 	if oldStatus.GetState() != StateReady && componentStatus.GetState() == StateReady ||
 		!reflect.DeepEqual(oldStatus.EndpointsForwarded, componentStatus.EndpointsForwarded) {
 
@@ -460,6 +595,14 @@ func (o *WatchClient) processEvents(
 	return nil
 }
 
+// eventReporter returns the reporter of the events of the Dev session, or a reporter ignoring the events if none is set
+func eventReporter(parameters WatchParameters) machineoutput.EventReporter {
+	if parameters.StartOptions.EventReporter == nil {
+		return machineoutput.NoOpEventReporter{}
+	}
+	return parameters.StartOptions.EventReporter
+}
+
 func shouldIgnoreEvent(event fsnotify.Event) (ignoreEvent bool) {
 	if !(event.Op&fsnotify.Remove == fsnotify.Remove || event.Op&fsnotify.Rename == fsnotify.Rename) {
 		stat, err := os.Lstat(event.Name)
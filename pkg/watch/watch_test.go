@@ -3,15 +3,23 @@ package watch
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	"github.com/redhat-developer/odo/pkg/informer"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 )
 
@@ -161,3 +169,196 @@ func Test_eventWatcher(t *testing.T) {
 		})
 	}
 }
+
+func Test_eventWatcher_pause(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxBatchSize int
+		// pauseWithKey pauses and resumes the sync with the s key, instead of calling Pause and Resume
+		pauseWithKey bool
+		// windows are the events sent while the sync is paused, waiting for the events of a window to be processed
+		// before sending the events of the next window
+		windows      [][]fsnotify.Event
+		wantContains []string
+		wantOut      string
+	}{
+		{
+			name: "changes in several windows are synced at once on resume",
+			windows: [][]fsnotify.Event{
+				{{Name: "file1", Op: fsnotify.Create}, {Name: "file2", Op: fsnotify.Write}},
+				{{Name: "file1", Op: fsnotify.Remove}, {Name: "file3", Op: fsnotify.Create}},
+			},
+			wantOut: "Sync of local changes paused\n\nSync of local changes resumed\n\n" +
+				"Pushing files...\n\nchangedFiles [file2 file3] deletedPaths [file1]\n",
+		},
+		{
+			name:         "sync paused and resumed with the s key",
+			pauseWithKey: true,
+			windows: [][]fsnotify.Event{
+				{{Name: "file1", Op: fsnotify.Create}},
+			},
+			wantOut: "Sync of local changes paused\n\nSync of local changes resumed\n\n" +
+				"Pushing files...\n\nchangedFiles [file1] deletedPaths []\n",
+		},
+		{
+			name:         "changes are synced when exceeding the maximal batch size",
+			maxBatchSize: 2,
+			windows: [][]fsnotify.Event{
+				{{Name: "file1", Op: fsnotify.Create}, {Name: "file2", Op: fsnotify.Write}},
+				{{Name: "file3", Op: fsnotify.Create}},
+			},
+			wantContains: []string{
+				"Sync is paused, but 3 changes are pending (maximum 2), pushing them",
+				"Pushing files...\n\nchangedFiles [file1 file2 file3] deletedPaths []\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher, _ := fsnotify.NewWatcher()
+			fileWatcher, _ := fsnotify.NewWatcher()
+			ctx, cancel := context.WithCancel(context.Background())
+			ctx = odocontext.WithDevfilePath(ctx, "/path/to/devfile")
+			ctx = odocontext.WithApplication(ctx, "odo")
+			ctx = odocontext.WithComponentName(ctx, "my-component")
+			out := &safeBuffer{}
+
+			o := NewWatchClient(nil, nil)
+			o.sourcesWatcher = watcher
+			o.deploymentWatcher = fakeWatcher{}
+			o.podWatcher = fakeWatcher{}
+			o.warningsWatcher = fakeWatcher{}
+			o.devfileWatcher = fileWatcher
+			keys := make(chan byte)
+			o.keyWatcher = keys
+			if !tt.pauseWithKey {
+				o.Pause()
+			}
+
+			go func() {
+				if tt.pauseWithKey {
+					keys <- 's'
+				}
+				for _, window := range tt.windows {
+					for _, event := range window {
+						watcher.Events <- event
+					}
+					<-time.After(300 * time.Millisecond)
+				}
+				if o.PendingChanges() == 0 && tt.maxBatchSize == 0 {
+					t.Errorf("expected pending changes while paused")
+				}
+				if tt.pauseWithKey {
+					keys <- 's'
+				} else {
+					o.Resume()
+				}
+				<-time.After(300 * time.Millisecond)
+				cancel()
+			}()
+
+			componentStatus := ComponentStatus{}
+			componentStatus.SetState(StateReady)
+			parameters := WatchParameters{MaxBatchSize: tt.maxBatchSize}
+			parameters.StartOptions.Out = out
+
+			// the component is ready after each successful sync
+			handler := func(ctx context.Context, params WatchParameters, changedFiles, deletedPaths []string, componentStatus *ComponentStatus) error {
+				componentStatus.SetState(StateReady)
+				return processEventsHandler(ctx, params, changedFiles, deletedPaths, componentStatus)
+			}
+			err := o.eventWatcher(ctx, parameters, evaluateChangesHandler, handler, componentStatus)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			gotOut := out.String()
+			if tt.wantOut != "" && gotOut != tt.wantOut {
+				t.Errorf("eventWatcher() gotOut = %q, want %q", gotOut, tt.wantOut)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(gotOut, want) {
+					t.Errorf("eventWatcher() gotOut = %q, should contain %q", gotOut, want)
+				}
+			}
+			if o.PendingChanges() != 0 {
+				t.Errorf("expected no pending changes after sync, got %d", o.PendingChanges())
+			}
+		})
+	}
+}
+
+// safeBuffer is a bytes.Buffer which can be written and read from different goroutines
+type safeBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (o *safeBuffer) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf.Write(p)
+}
+
+func (o *safeBuffer) String() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf.String()
+}
+
+func Test_processEvents_eventReporter(t *testing.T) {
+	tests := []struct {
+		name       string
+		handlerErr error
+		want       []machineoutput.DevEventType
+	}{
+		{
+			name: "successful push",
+			want: []machineoutput.DevEventType{
+				machineoutput.DevEventStepStarted,
+				machineoutput.DevEventFilesSynced,
+				machineoutput.DevEventStepSucceeded,
+			},
+		},
+		{
+			name:       "failed push",
+			handlerErr: errors.New("container not running"),
+			want: []machineoutput.DevEventType{
+				machineoutput.DevEventStepStarted,
+				machineoutput.DevEventStepFailed,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := odocontext.WithDevfilePath(context.Background(), "/path/to/devfile")
+			var events bytes.Buffer
+			parameters := WatchParameters{
+				DevfileWatchHandler: func(context.Context, common.PushParameters, *ComponentStatus) error {
+					return tt.handlerErr
+				},
+			}
+			parameters.StartOptions.Out = &bytes.Buffer{}
+			parameters.StartOptions.EventReporter = machineoutput.NewJSONEventReporter(&events, "my-component")
+
+			o := NewWatchClient(nil, informer.NewInformerClient())
+			err := o.processEvents(ctx, parameters, []string{"file1"}, nil, &ComponentStatus{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []machineoutput.DevEventType
+			decoder := json.NewDecoder(&events)
+			for decoder.More() {
+				var event machineoutput.DevEvent
+				if err = decoder.Decode(&event); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, event.Type)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("processEvents() events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}